@@ -0,0 +1,239 @@
+package linq
+
+import (
+	"fmt"
+	"testing"
+)
+
+var benchSizes = []int{10, 1000, 100000}
+
+func benchList(n int) *List[int] {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = (i * 7919) % n
+	}
+	return From(s)
+}
+
+func isEven(value int, index int) bool {
+	return value%2 == 0
+}
+
+func isNegative(value int, index int) bool {
+	return value < 0
+}
+
+func toFloat(value int, index int) float64 {
+	return float64(value)
+}
+
+func compareInt(a, b int) int {
+	return a - b
+}
+
+// benchmark runs f with lists of each size in benchSizes.
+func benchmark(b *testing.B, f func(b *testing.B, l *List[int])) {
+	for _, n := range benchSizes {
+		l := benchList(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				f(b, l)
+			}
+		})
+	}
+}
+
+func BenchmarkList_First(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_, _ = l.First(isNegative)
+	})
+}
+
+func BenchmarkList_Last(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_, _ = l.Last(isNegative)
+	})
+}
+
+func BenchmarkList_At(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_, _ = l.At(l.Count() / 2)
+	})
+}
+
+func BenchmarkList_Skip(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Skip(l.Count() / 2)
+	})
+}
+
+func BenchmarkList_SkipWhile(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.SkipWhile(isNegative)
+	})
+}
+
+func BenchmarkList_Take(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Take(l.Count() / 2)
+	})
+}
+
+func BenchmarkList_TakeWhile(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.TakeWhile(isNegative)
+	})
+}
+
+func BenchmarkList_Where(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Where(isEven)
+	})
+}
+
+func BenchmarkList_All(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.All(func(value int, index int) bool {
+			return value >= 0
+		})
+	})
+}
+
+func BenchmarkList_Any(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Any(isNegative)
+	})
+}
+
+func BenchmarkList_Contains(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Contains(-1)
+	})
+}
+
+func BenchmarkList_SequenceEqual(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.SequenceEqual(l)
+	})
+}
+
+func BenchmarkList_Count(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Count(isEven)
+	})
+}
+
+func BenchmarkList_Max(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Max(toFloat)
+	})
+}
+
+func BenchmarkList_Min(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Min(toFloat)
+	})
+}
+
+func BenchmarkList_Average(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Average(toFloat)
+	})
+}
+
+func BenchmarkList_Sum(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Sum(toFloat)
+	})
+}
+
+func BenchmarkList_Reverse(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Reverse()
+	})
+}
+
+func BenchmarkList_Distinct(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.Distinct()
+	})
+}
+
+func BenchmarkList_OrderBy(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.OrderBy(compareInt)
+	})
+}
+
+func BenchmarkSortedList_BinarySearch(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		b.StopTimer()
+		s := l.OrderBy(compareInt)
+		b.StartTimer()
+		_, _ = s.BinarySearch(l.Count() / 2)
+	})
+}
+
+func BenchmarkList_ToSet(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = l.ToSet()
+	})
+}
+
+func BenchmarkList_Paginate(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_, _ = l.Paginate(2, 10)
+	})
+}
+
+func BenchmarkIndexBy(b *testing.B) {
+	benchmark(b, func(b *testing.B, l *List[int]) {
+		_ = IndexBy(l, func(value int, index int) int {
+			return value
+		})
+	})
+}
+
+func TestList_Allocs(t *testing.T) {
+	if testing.CoverMode() != "" {
+		t.Skip("allocations differ with coverage")
+	}
+	l := benchList(1000)
+	words := From([]string{"a", "bb", "ccc", "dddd"})
+	tests := []struct {
+		name string
+		max  float64
+		f    func()
+	}{
+		{"First", 0, func() { _, _ = l.First(isNegative) }},
+		{"Last", 0, func() { _, _ = l.Last(isNegative) }},
+		{"At", 0, func() { _, _ = l.At(10) }},
+		{"FirstOrDefault", 0, func() { _ = l.FirstOrDefault(isNegative) }},
+		{"All", 0, func() { _ = l.All(isEven) }},
+		{"Any", 0, func() { _ = l.Any(isNegative) }},
+		{"Contains", 0, func() { _ = l.Contains(-1) }},
+		{"SequenceEqual", 0, func() { _ = l.SequenceEqual(l) }},
+		{"Count", 0, func() { _ = l.Count(isEven) }},
+		{"Max", 0, func() { _ = l.Max(toFloat) }},
+		{"Min", 0, func() { _ = l.Min(toFloat) }},
+		{"Average", 0, func() { _ = l.Average(toFloat) }},
+		{"Sum", 0, func() { _ = l.Sum(toFloat) }},
+		{"ToSlice", 0, func() { _ = l.ToSlice() }},
+		{"Skip", 1, func() { _ = l.Skip(10) }},
+		{"Take", 1, func() { _ = l.Take(10) }},
+		{"SkipWhile", 1, func() { _ = l.SkipWhile(isEven) }},
+		{"TakeWhile", 1, func() { _ = l.TakeWhile(isEven) }},
+		{"Where", 2, func() { _ = l.Where(isEven) }},
+		{"Reverse", 2, func() { _ = l.Reverse() }},
+		{"BinarySearch", 0, func() { _, _ = l.AsSorted(compareInt).BinarySearch(1) }},
+		{"JoinStrings", 1, func() { _ = words.JoinStrings(", ") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testing.AllocsPerRun(100, tt.f); got > tt.max {
+				t.Errorf("%v() allocs = %v, want at most %v", tt.name, got, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package linq
+
+import (
+	"fmt"
+)
+
+// ListBuilder builds List with in-place operations.
+// Lists returned by Build are not affected by later operations.
+type ListBuilder[T comparable] struct {
+	slice []T
+}
+
+// NewListBuilder is constructor of ListBuilder.
+func NewListBuilder[T comparable](capacity ...int) *ListBuilder[T] {
+	c := 0
+	if len(capacity) > 0 && capacity[0] > 0 {
+		c = capacity[0]
+	}
+
+	return &ListBuilder[T]{
+		slice: make([]T, 0, c),
+	}
+}
+
+// ToBuilder returns builder initialized with elements
+func (l *List[T]) ToBuilder() *ListBuilder[T] {
+	b := NewListBuilder[T](len(l.items()))
+	b.slice = append(b.slice, l.items()...)
+	return b
+}
+
+// Len returns number of elements
+func (b *ListBuilder[T]) Len() int {
+	return len(b.slice)
+}
+
+// Cap returns capacity of builder
+func (b *ListBuilder[T]) Cap() int {
+	return cap(b.slice)
+}
+
+// Grow grows capacity to hold at least n more elements
+func (b *ListBuilder[T]) Grow(n int) *ListBuilder[T] {
+	if n > 0 && cap(b.slice)-len(b.slice) < n {
+		s := make([]T, len(b.slice), len(b.slice)+n)
+		copy(s, b.slice)
+		b.slice = s
+	}
+	return b
+}
+
+// TrimExcess shrinks capacity to number of elements
+func (b *ListBuilder[T]) TrimExcess() *ListBuilder[T] {
+	if cap(b.slice) > len(b.slice) {
+		s := make([]T, len(b.slice))
+		copy(s, b.slice)
+		b.slice = s
+	}
+	return b
+}
+
+// Add appends value
+func (b *ListBuilder[T]) Add(value T) *ListBuilder[T] {
+	b.slice = append(b.slice, value)
+	return b
+}
+
+// AddRange appends values
+func (b *ListBuilder[T]) AddRange(values ...T) *ListBuilder[T] {
+	b.slice = append(b.slice, values...)
+	return b
+}
+
+// Insert inserts values at index.
+// If index is out of range, then it returns error.
+func (b *ListBuilder[T]) Insert(index int, values ...T) error {
+	if index < 0 || len(b.slice) < index {
+		return fmt.Errorf("out of index: %v", index)
+	}
+
+	b.slice = append(b.slice, values...)
+	copy(b.slice[index+len(values):], b.slice[index:])
+	copy(b.slice[index:], values)
+
+	return nil
+}
+
+// Set replaces element at index.
+// If index is out of range, then it returns error.
+func (b *ListBuilder[T]) Set(index int, value T) error {
+	if index < 0 || len(b.slice) <= index {
+		return fmt.Errorf("out of index: %v", index)
+	}
+
+	b.slice[index] = value
+	return nil
+}
+
+// RemoveAt removes element at index.
+// If index is out of range, then it returns error.
+func (b *ListBuilder[T]) RemoveAt(index int) error {
+	if index < 0 || len(b.slice) <= index {
+		return fmt.Errorf("out of index: %v", index)
+	}
+
+	copy(b.slice[index:], b.slice[index+1:])
+	b.slice[len(b.slice)-1] = *new(T)
+	b.slice = b.slice[:len(b.slice)-1]
+
+	return nil
+}
+
+// RemoveAll removes condition matched elements and returns number of removed elements
+func (b *ListBuilder[T]) RemoveAll(f func(value T, index int) bool) int {
+	n := 0
+	for i, t := range b.slice {
+		if !f(t, i) {
+			b.slice[n] = t
+			n++
+		}
+	}
+	removed := len(b.slice) - n
+	for i := n; i < len(b.slice); i++ {
+		b.slice[i] = *new(T)
+	}
+	b.slice = b.slice[:n]
+
+	return removed
+}
+
+// Clear removes all elements and keeps capacity
+func (b *ListBuilder[T]) Clear() *ListBuilder[T] {
+	for i := range b.slice {
+		b.slice[i] = *new(T)
+	}
+	b.slice = b.slice[:0]
+	return b
+}
+
+// Build returns list of current elements
+func (b *ListBuilder[T]) Build() *List[T] {
+	s := make([]T, len(b.slice))
+	copy(s, b.slice)
+	return From(s)
+}
@@ -0,0 +1,290 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewListBuilder(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity []int
+		want     int
+	}{
+		{
+			name:     "no capacity",
+			capacity: nil,
+			want:     0,
+		},
+		{
+			name:     "with capacity",
+			capacity: []int{10},
+			want:     10,
+		},
+		{
+			name:     "negative capacity",
+			capacity: []int{-1},
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewListBuilder[T](tt.capacity...)
+			if got := b.Cap(); got != tt.want {
+				t.Errorf("Cap() = %v, want %v", got, tt.want)
+			}
+			if got := b.Len(); got != 0 {
+				t.Errorf("Len() = %v, want %v", got, 0)
+			}
+		})
+	}
+}
+
+func TestListBuilder_Build(t *testing.T) {
+	b := NewListBuilder[T]().Add(1).AddRange(2, 3)
+	l := b.Build()
+	b.Add(4)
+	if err := b.Set(0, 10); err != nil {
+		t.Errorf("Set() error = %v", err)
+	}
+
+	if want := (&List[T]{slice: []T{1, 2, 3}}); !reflect.DeepEqual(l, want) {
+		t.Errorf("Build() = %v, want %v", l, want)
+	}
+	if want := (&List[T]{slice: []T{10, 2, 3, 4}}); !reflect.DeepEqual(b.Build(), want) {
+		t.Errorf("Build() = %v, want %v", b.Build(), want)
+	}
+}
+
+func TestList_ToBuilder(t *testing.T) {
+	s := []T{1, 2, 3}
+	b := From(s).ToBuilder()
+	b.Add(4)
+	if err := b.Set(0, 10); err != nil {
+		t.Errorf("Set() error = %v", err)
+	}
+
+	if want := []T{1, 2, 3}; !reflect.DeepEqual(s, want) {
+		t.Errorf("ToBuilder() changed source = %v, want %v", s, want)
+	}
+	if want := []T{10, 2, 3, 4}; !reflect.DeepEqual(b.Build().ToSlice(), want) {
+		t.Errorf("Build() = %v, want %v", b.Build().ToSlice(), want)
+	}
+}
+
+func TestListBuilder_Insert(t *testing.T) {
+	type args struct {
+		index  int
+		values []T
+	}
+	tests := []struct {
+		name    string
+		fields  []T
+		args    args
+		want    []T
+		wantErr bool
+	}{
+		{
+			name:   "insert to head",
+			fields: []T{1, 2, 3},
+			args: args{
+				index:  0,
+				values: []T{8, 9},
+			},
+			want:    []T{8, 9, 1, 2, 3},
+			wantErr: false,
+		},
+		{
+			name:   "insert to middle",
+			fields: []T{1, 2, 3},
+			args: args{
+				index:  1,
+				values: []T{9},
+			},
+			want:    []T{1, 9, 2, 3},
+			wantErr: false,
+		},
+		{
+			name:   "insert to tail",
+			fields: []T{1, 2, 3},
+			args: args{
+				index:  3,
+				values: []T{9},
+			},
+			want:    []T{1, 2, 3, 9},
+			wantErr: false,
+		},
+		{
+			name:   "index is lower than 0",
+			fields: []T{1, 2, 3},
+			args: args{
+				index:  -1,
+				values: []T{9},
+			},
+			want:    []T{1, 2, 3},
+			wantErr: true,
+		},
+		{
+			name:   "index is over max elements",
+			fields: []T{1, 2, 3},
+			args: args{
+				index:  4,
+				values: []T{9},
+			},
+			want:    []T{1, 2, 3},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := From(tt.fields).ToBuilder()
+			if err := b.Insert(tt.args.index, tt.args.values...); (err != nil) != tt.wantErr {
+				t.Errorf("Insert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := b.Build().ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Insert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListBuilder_Set(t *testing.T) {
+	type args struct {
+		index int
+		value T
+	}
+	tests := []struct {
+		name    string
+		fields  []T
+		args    args
+		want    []T
+		wantErr bool
+	}{
+		{
+			name:   "set element by index",
+			fields: []T{1, 2, 3},
+			args: args{
+				index: 2,
+				value: 9,
+			},
+			want:    []T{1, 2, 9},
+			wantErr: false,
+		},
+		{
+			name:   "index is over max elements",
+			fields: []T{1, 2, 3},
+			args: args{
+				index: 3,
+				value: 9,
+			},
+			want:    []T{1, 2, 3},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := From(tt.fields).ToBuilder()
+			if err := b.Set(tt.args.index, tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := b.Build().ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Set() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListBuilder_RemoveAt(t *testing.T) {
+	type args struct {
+		index int
+	}
+	tests := []struct {
+		name    string
+		fields  []T
+		args    args
+		want    []T
+		wantErr bool
+	}{
+		{
+			name:   "remove element by index",
+			fields: []T{1, 2, 3},
+			args: args{
+				index: 1,
+			},
+			want:    []T{1, 3},
+			wantErr: false,
+		},
+		{
+			name:   "remove last element",
+			fields: []T{1, 2, 3},
+			args: args{
+				index: 2,
+			},
+			want:    []T{1, 2},
+			wantErr: false,
+		},
+		{
+			name:   "index is lower than 0",
+			fields: []T{1, 2, 3},
+			args: args{
+				index: -1,
+			},
+			want:    []T{1, 2, 3},
+			wantErr: true,
+		},
+		{
+			name:   "empty builder",
+			fields: []T{},
+			args: args{
+				index: 0,
+			},
+			want:    []T{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := From(tt.fields).ToBuilder()
+			if err := b.RemoveAt(tt.args.index); (err != nil) != tt.wantErr {
+				t.Errorf("RemoveAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := b.Build().ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RemoveAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListBuilder_RemoveAll(t *testing.T) {
+	b := From([]T{1, 2, 3, 4, 5}).ToBuilder()
+	got := b.RemoveAll(func(value T, index int) bool {
+		return value%2 == 0
+	})
+
+	if got != 2 {
+		t.Errorf("RemoveAll() = %v, want %v", got, 2)
+	}
+	if want := []T{1, 3, 5}; !reflect.DeepEqual(b.Build().ToSlice(), want) {
+		t.Errorf("RemoveAll() = %v, want %v", b.Build().ToSlice(), want)
+	}
+}
+
+func TestListBuilder_Clear(t *testing.T) {
+	b := NewListBuilder[T](4).AddRange(1, 2, 3).Clear()
+	if b.Len() != 0 || b.Cap() != 4 {
+		t.Errorf("Clear() len = %v, cap = %v, want 0, 4", b.Len(), b.Cap())
+	}
+}
+
+func TestListBuilder_Capacity(t *testing.T) {
+	b := NewListBuilder[T]().AddRange(1, 2).Grow(10)
+	if b.Cap() < 12 {
+		t.Errorf("Grow() cap = %v, want at least %v", b.Cap(), 12)
+	}
+	if b.TrimExcess().Cap() != 2 {
+		t.Errorf("TrimExcess() cap = %v, want %v", b.Cap(), 2)
+	}
+	if want := []T{1, 2}; !reflect.DeepEqual(b.Build().ToSlice(), want) {
+		t.Errorf("Build() = %v, want %v", b.Build().ToSlice(), want)
+	}
+}
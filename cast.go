@@ -0,0 +1,45 @@
+package linq
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// OfType returns elements which are of type R.
+// Elements are filtered by type assertion, so R can be concrete type or interface.
+func OfType[T comparable, R comparable](l *List[T]) *List[R] {
+	s := make([]R, 0, len(l.items()))
+	for _, t := range l.items() {
+		if r, ok := any(t).(R); ok {
+			s = append(s, r)
+		}
+	}
+
+	return From(s)
+}
+
+// Cast returns elements asserted to type R.
+// If an element is not of type R, then it returns error naming its index.
+func Cast[T comparable, R comparable](l *List[T]) (*List[R], error) {
+	s := make([]R, len(l.items()))
+	for i, t := range l.items() {
+		r, ok := any(t).(R)
+		if !ok {
+			return nil, fmt.Errorf("can not cast element at index %v: %T is not %v", i, t, reflect.TypeOf((*R)(nil)).Elem())
+		}
+		s[i] = r
+	}
+
+	return From(s), nil
+}
+
+// MustCast returns elements asserted to type R.
+// If an element is not of type R, then it raises panic.
+func MustCast[T comparable, R comparable](l *List[T]) *List[R] {
+	r, err := Cast[T, R](l)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
@@ -0,0 +1,109 @@
+package linq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type Event interface {
+	User() string
+}
+
+type LoginEvent struct {
+	user string
+}
+
+func (e *LoginEvent) User() string { return e.user }
+
+type LogoutEvent struct {
+	user string
+}
+
+func (e *LogoutEvent) User() string { return e.user }
+
+func TestOfType(t *testing.T) {
+	login1 := &LoginEvent{user: "a"}
+	login2 := &LoginEvent{user: "b"}
+	logout := &LogoutEvent{user: "a"}
+	tests := []struct {
+		name  string
+		slice []Event
+		want  *List[*LoginEvent]
+	}{
+		{
+			name:  "filter concrete type",
+			slice: []Event{login1, logout, nil, login2},
+			want:  &List[*LoginEvent]{slice: []*LoginEvent{login1, login2}},
+		},
+		{
+			name:  "no matched element",
+			slice: []Event{logout},
+			want:  &List[*LoginEvent]{slice: []*LoginEvent{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OfType[Event, *LoginEvent](From(tt.slice)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OfType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOfType_Interface(t *testing.T) {
+	login := &LoginEvent{user: "a"}
+	got := OfType[any, Event](From([]any{1, login, "a", nil}))
+	if want := (&List[Event]{slice: []Event{login}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("OfType() = %v, want %v", got, want)
+	}
+}
+
+func TestCast(t *testing.T) {
+	login1 := &LoginEvent{user: "a"}
+	login2 := &LoginEvent{user: "b"}
+	logout := &LogoutEvent{user: "a"}
+	tests := []struct {
+		name    string
+		slice   []Event
+		want    *List[*LoginEvent]
+		wantErr string
+	}{
+		{
+			name:  "cast all elements",
+			slice: []Event{login1, login2},
+			want:  &List[*LoginEvent]{slice: []*LoginEvent{login1, login2}},
+		},
+		{
+			name:    "element of other type",
+			slice:   []Event{login1, logout},
+			wantErr: "index 1: *linq.LogoutEvent is not *linq.LoginEvent",
+		},
+		{
+			name:    "nil element",
+			slice:   []Event{nil},
+			wantErr: "index 0: <nil> is not *linq.LoginEvent",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Cast[Event, *LoginEvent](From(tt.slice))
+			if (err != nil) != (tt.wantErr != "") || err != nil && !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Cast() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustCast(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("MustCast() panic = %v, raised %v", err, true)
+		}
+	}()
+	MustCast[any, int](From([]any{1, "a"}))
+}
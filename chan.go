@@ -0,0 +1,95 @@
+package linq
+
+import (
+	"context"
+	"sync"
+)
+
+// FromChan reads elements from ch until it is closed.
+func FromChan[T comparable](ch <-chan T) *List[T] {
+	l, _ := FromChanContext(context.Background(), ch)
+	return l
+}
+
+// FromChanContext reads elements from ch until it is closed or ctx is done.
+// If ctx is done first, then it returns elements read so far with ctx.Err().
+func FromChanContext[T comparable](ctx context.Context, ch <-chan T) (*List[T], error) {
+	seq, errf := FromChanSeq(ctx, ch)
+	l := seq.ToList()
+	return l, errf()
+}
+
+// FromChanSeq returns lazy sequence of elements received from ch.
+// Each enumeration receives elements until ch is closed or ctx is done, and stops receiving
+// as soon as enumeration stops. Returned function returns ctx.Err() if ctx was done first.
+func FromChanSeq[T comparable](ctx context.Context, ch <-chan T) (*Sequence[T], func() error) {
+	var err error
+	seq := FromSeq(func(yield func(T) bool) {
+		err = nil
+		for {
+			select {
+			case t, ok := <-ch:
+				if !ok || !yield(t) {
+					return
+				}
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+		}
+	})
+
+	return seq, func() error { return err }
+}
+
+// ToChan emits elements to returned channel from a goroutine.
+// The channel is closed after the last element or when ctx is done.
+func (l *List[T]) ToChan(ctx context.Context, buffer int) <-chan T {
+	ch := make(chan T, buffer)
+	go func() {
+		defer close(ch)
+		for _, t := range l.items() {
+			select {
+			case ch <- t:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Merge emits elements of all channels to returned channel in arrival order.
+// The channel is closed after all channels are closed or when ctx is done.
+func Merge[T comparable](ctx context.Context, chs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(chs))
+	for _, ch := range chs {
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for {
+				select {
+				case t, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- t:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
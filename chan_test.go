@@ -0,0 +1,211 @@
+package linq
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sort"
+	"testing"
+	"time"
+)
+
+// checkGoroutines fails the test if goroutines started during the test are still running at its end.
+func checkGoroutines(t *testing.T) {
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				buf := make([]byte, 1<<16)
+				t.Errorf("leaked goroutines: %v > %v\n%s", runtime.NumGoroutine(), before, buf[:runtime.Stack(buf, true)])
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
+
+func sendAll(s []T) <-chan T {
+	ch := make(chan T, len(s))
+	for _, t := range s {
+		ch <- t
+	}
+	close(ch)
+	return ch
+}
+
+func TestFromChan(t *testing.T) {
+	type args struct {
+		ch <-chan T
+	}
+	tests := []struct {
+		name string
+		args args
+		want *List[T]
+	}{
+		{
+			name: "read until closed",
+			args: args{
+				ch: sendAll([]T{1, 2, 3}),
+			},
+			want: &List[T]{
+				slice: []T{1, 2, 3},
+			},
+		},
+		{
+			name: "closed channel",
+			args: args{
+				ch: sendAll(nil),
+			},
+			want: &List[T]{
+				slice: []T{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromChan(tt.args.ch); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromChan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromChanContext(t *testing.T) {
+	ch := make(chan T, 2)
+	ch <- 1
+	ch <- 2
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	got, err := FromChanContext(ctx, ch)
+	if err != context.DeadlineExceeded {
+		t.Errorf("FromChanContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if want := (&List[T]{slice: []T{1, 2}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("FromChanContext() = %v, want %v", got, want)
+	}
+}
+
+func TestFromChanSeq(t *testing.T) {
+	ch := make(chan T, 5)
+	for i := 0; i < 5; i++ {
+		ch <- T(i)
+	}
+	seq, errf := FromChanSeq(context.Background(), ch)
+
+	if got := seq.Take(2).ToSlice(); !reflect.DeepEqual(got, []T{0, 1}) {
+		t.Errorf("Take() = %v, want %v", got, []T{0, 1})
+	}
+	if len(ch) != 3 {
+		t.Errorf("%v elements left in channel, want %v", len(ch), 3)
+	}
+	close(ch)
+	if got := seq.ToSlice(); !reflect.DeepEqual(got, []T{2, 3, 4}) || errf() != nil {
+		t.Errorf("ToSlice() = %v, %v, want %v", got, errf(), []T{2, 3, 4})
+	}
+}
+
+func TestFromChanSeq_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan T)
+	seq, errf := FromChanSeq(ctx, ch)
+
+	go func() {
+		ch <- 1
+		cancel()
+	}()
+	if got := seq.ToSlice(); !reflect.DeepEqual(got, []T{1}) || errf() != context.Canceled {
+		t.Errorf("ToSlice() = %v, %v, want %v, %v", got, errf(), []T{1}, context.Canceled)
+	}
+}
+
+func TestList_ToChan(t *testing.T) {
+	type args struct {
+		buffer int
+	}
+	tests := []struct {
+		name   string
+		fields []T
+		args   args
+		want   *List[T]
+	}{
+		{
+			name:   "unbuffered",
+			fields: []T{1, 2, 3},
+			args: args{
+				buffer: 0,
+			},
+			want: &List[T]{
+				slice: []T{1, 2, 3},
+			},
+		},
+		{
+			name:   "buffered",
+			fields: []T{1, 2, 3},
+			args: args{
+				buffer: 10,
+			},
+			want: &List[T]{
+				slice: []T{1, 2, 3},
+			},
+		},
+		{
+			name:   "empty list",
+			fields: []T{},
+			args: args{
+				buffer: 0,
+			},
+			want: &List[T]{
+				slice: []T{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGoroutines(t)
+			l := From(tt.fields)
+			if got := FromChan(l.ToChan(context.Background(), tt.args.buffer)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToChan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_ToChan_Cancel(t *testing.T) {
+	checkGoroutines(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := From([]T{1, 2, 3, 4, 5}).ToChan(ctx, 0)
+	if got := <-ch; got != 1 {
+		t.Errorf("ToChan() first = %v, want %v", got, 1)
+	}
+	cancel()
+	for range ch {
+	}
+}
+
+func TestMerge(t *testing.T) {
+	checkGoroutines(t)
+	ctx := context.Background()
+	a := From([]T{1, 2, 3}).ToChan(ctx, 0)
+	b := From([]T{4, 5}).ToChan(ctx, 1)
+
+	got := FromChan(Merge(ctx, a, b, sendAll(nil))).ToSlice()
+	sort.Slice(got, func(i, j int) bool {
+		return got[i] < got[j]
+	})
+	if want := []T{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
+
+func TestMerge_Cancel(t *testing.T) {
+	checkGoroutines(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	blocked := make(chan T)
+	out := Merge(ctx, From([]T{1, 2, 3}).ToChan(ctx, 0), blocked)
+	<-out
+	cancel()
+	for range out {
+	}
+}
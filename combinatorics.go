@@ -0,0 +1,139 @@
+package linq
+
+// pick returns list of elements of s at indexes.
+func pick[T comparable](s []T, indexes []int) *List[T] {
+	r := make([]T, len(indexes))
+	for i, j := range indexes {
+		r[i] = s[j]
+	}
+	return From(r)
+}
+
+// CartesianProduct returns lazy sequence of every list which takes one element from each list.
+// Results are in lexicographic order of indexes, so the last list varies fastest.
+// If any list is empty, then it returns empty sequence. Without lists, it returns one empty list.
+func CartesianProduct[T comparable](lists ...*List[T]) *Sequence[*List[T]] {
+	return FromSeq(func(yield func(*List[T]) bool) {
+		for _, l := range lists {
+			if len(l.items()) == 0 {
+				return
+			}
+		}
+
+		indexes := make([]int, len(lists))
+		for {
+			r := make([]T, len(lists))
+			for i, l := range lists {
+				r[i] = l.items()[indexes[i]]
+			}
+			if !yield(From(r)) {
+				return
+			}
+
+			i := len(lists) - 1
+			for ; i >= 0; i-- {
+				if indexes[i]++; indexes[i] < len(lists[i].items()) {
+					break
+				}
+				indexes[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	})
+}
+
+// Permutations returns lazy sequence of every ordered arrangement of k elements.
+// Results are in lexicographic order of indexes.
+// If k is lower than 0 or greater than length of list, then it returns empty sequence.
+func Permutations[T comparable](l *List[T], k int) *Sequence[*List[T]] {
+	s := l.items()
+	return FromSeq(func(yield func(*List[T]) bool) {
+		if k < 0 || k > len(s) {
+			return
+		}
+		used := make([]bool, len(s))
+		indexes := make([]int, 0, k)
+
+		var permute func() bool
+		permute = func() bool {
+			if len(indexes) == k {
+				return yield(pick(s, indexes))
+			}
+			for i := range s {
+				if used[i] {
+					continue
+				}
+				used[i] = true
+				indexes = append(indexes, i)
+				ok := permute()
+				indexes = indexes[:len(indexes)-1]
+				used[i] = false
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		permute()
+	})
+}
+
+// Combinations returns lazy sequence of every selection of k elements which keeps their order.
+// Results are in lexicographic order of indexes.
+// If k is lower than 0 or greater than length of list, then it returns empty sequence.
+func Combinations[T comparable](l *List[T], k int) *Sequence[*List[T]] {
+	s := l.items()
+	return FromSeq(func(yield func(*List[T]) bool) {
+		if k < 0 || k > len(s) {
+			return
+		}
+		indexes := make([]int, k)
+		for i := range indexes {
+			indexes[i] = i
+		}
+		for {
+			if !yield(pick(s, indexes)) {
+				return
+			}
+
+			i := k - 1
+			for ; i >= 0 && indexes[i] == len(s)-k+i; i-- {
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	})
+}
+
+// PowerSet returns lazy sequence of every subset of elements, which keep their order.
+// Results are in lexicographic order of indexes, starting from empty list.
+func PowerSet[T comparable](l *List[T]) *Sequence[*List[T]] {
+	s := l.items()
+	return FromSeq(func(yield func(*List[T]) bool) {
+		indexes := make([]int, 0, len(s))
+
+		var subsets func(start int) bool
+		subsets = func(start int) bool {
+			if !yield(pick(s, indexes)) {
+				return false
+			}
+			for i := start; i < len(s); i++ {
+				indexes = append(indexes, i)
+				ok := subsets(i + 1)
+				indexes = indexes[:len(indexes)-1]
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		subsets(0)
+	})
+}
@@ -0,0 +1,157 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+// listSlices returns elements of each list in sequence.
+func listSlices[T comparable](seq *Sequence[*List[T]]) [][]T {
+	r := make([][]T, 0)
+	for l := range seq.Seq() {
+		r = append(r, l.ToSlice())
+	}
+	return r
+}
+
+func TestCartesianProduct(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists []*List[string]
+		want  [][]string
+	}{
+		{
+			name:  "product of lists",
+			lists: []*List[string]{From([]string{"us", "eu"}), From([]string{"free", "pro"}), From([]string{"on"})},
+			want: [][]string{
+				{"us", "free", "on"},
+				{"us", "pro", "on"},
+				{"eu", "free", "on"},
+				{"eu", "pro", "on"},
+			},
+		},
+		{
+			name:  "empty list",
+			lists: []*List[string]{From([]string{"us"}), From([]string{})},
+			want:  [][]string{},
+		},
+		{
+			name:  "no list",
+			lists: nil,
+			want:  [][]string{{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listSlices(CartesianProduct(tt.lists...)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CartesianProduct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	l := From([]T{1, 2, 3})
+	tests := []struct {
+		name string
+		k    int
+		want [][]T
+	}{
+		{
+			name: "all elements",
+			k:    3,
+			want: [][]T{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}},
+		},
+		{
+			name: "some elements",
+			k:    2,
+			want: [][]T{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}},
+		},
+		{
+			name: "no element",
+			k:    0,
+			want: [][]T{{}},
+		},
+		{
+			name: "too many elements",
+			k:    4,
+			want: [][]T{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listSlices(Permutations(l, tt.k)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Permutations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	l := From([]T{1, 2, 3, 4})
+	tests := []struct {
+		name string
+		k    int
+		want [][]T
+	}{
+		{
+			name: "pairs",
+			k:    2,
+			want: [][]T{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}},
+		},
+		{
+			name: "all elements",
+			k:    4,
+			want: [][]T{{1, 2, 3, 4}},
+		},
+		{
+			name: "no element",
+			k:    0,
+			want: [][]T{{}},
+		},
+		{
+			name: "negative k",
+			k:    -1,
+			want: [][]T{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listSlices(Combinations(l, tt.k)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Combinations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	want := [][]T{{}, {1}, {1, 2}, {1, 2, 3}, {1, 3}, {2}, {2, 3}, {3}}
+	if got := listSlices(PowerSet(From([]T{1, 2, 3}))); !reflect.DeepEqual(got, want) {
+		t.Errorf("PowerSet() = %v, want %v", got, want)
+	}
+	if got := listSlices(PowerSet[T](nil)); !reflect.DeepEqual(got, [][]T{{}}) {
+		t.Errorf("PowerSet() = %v, want only empty list", got)
+	}
+}
+
+func TestCombinatorics_StopsEarly(t *testing.T) {
+	huge := make([]T, 30)
+	for i := range huge {
+		huge[i] = T(i)
+	}
+	l := From(huge)
+
+	got, err := Permutations(l, 30).First(func(p *List[T], i int) bool { return p.MustAt(28) == 29 })
+	if err != nil || got.MustLast() != 28 {
+		t.Errorf("Permutations().First() = %v, %v", got, err)
+	}
+	if got := PowerSet(l).Skip(5).Take(3).Count(); got != 3 {
+		t.Errorf("PowerSet().Take() = %v elements, want %v", got, 3)
+	}
+	if !Combinations(l, 15).Any() {
+		t.Errorf("Combinations().Any() = false, want true")
+	}
+	if !CartesianProduct(l, l, l, l, l, l, l, l).Any(func(p *List[T], i int) bool { return i == 1000 }) {
+		t.Errorf("CartesianProduct().Any() = false, want true")
+	}
+}
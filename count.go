@@ -0,0 +1,136 @@
+package linq
+
+import (
+	"slices"
+)
+
+// Keep selects which element DistinctBy keeps among elements with the same key.
+type Keep int
+
+const (
+	// KeepFirst keeps first element of each key.
+	KeepFirst Keep = iota
+	// KeepLast keeps last element of each key.
+	KeepLast
+)
+
+// CountBy returns number of elements of each key.
+// Keys are ordered by their first appearance.
+func CountBy[T comparable, K comparable](l *List[T], key func(value T, index int) K) *Dictionary[K, int] {
+	d := &Dictionary[K, int]{
+		m:     make(map[K]int),
+		slice: make([]KeyValue[K, int], 0),
+	}
+	for i, t := range l.items() {
+		k := key(t, i)
+		if j, ok := d.m[k]; ok {
+			d.slice[j].Value++
+			continue
+		}
+		d.m[k] = len(d.slice)
+		d.slice = append(d.slice, KeyValue[K, int]{Key: k, Value: 1})
+	}
+
+	return d
+}
+
+// DistinctBy returns one element of each key.
+// keep selects first or last element of each key, and defaults to KeepFirst.
+// Elements keep order of their positions in list.
+func DistinctBy[T comparable, K comparable](l *List[T], key func(value T, index int) K, keep ...Keep) *List[T] {
+	sp := l.span("DistinctBy")
+	items := l.items()
+	seen := make(map[K]struct{}, len(items))
+	s := make([]T, 0, len(items))
+	if len(keep) > 0 && keep[0] == KeepLast {
+		for i := len(items) - 1; i >= 0; i-- {
+			k := key(items[i], i)
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				s = append(s, items[i])
+			}
+		}
+		slices.Reverse(s)
+		return sp.end(s)
+	}
+
+	for i, t := range items {
+		k := key(t, i)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			s = append(s, t)
+		}
+	}
+
+	return sp.end(s)
+}
+
+// Duplicates returns elements which appear more than once.
+// Each element is returned once, in order of its first appearance.
+// Elements are compared by eq, Equal method of T or == in this order, the same as Distinct.
+func (l *List[T]) Duplicates(eq ...Equaler[T]) *List[T] {
+	sp := l.span("Duplicates")
+	items := l.items()
+	keys := make([]T, 0, len(items))
+	counts := make([]int, 0, len(items))
+	// count increments count of key at i, or adds t as new key if i is negative.
+	count := func(t T, i int) int {
+		if i < 0 {
+			keys = append(keys, t)
+			counts = append(counts, 1)
+			return len(keys) - 1
+		}
+		counts[i]++
+		return i
+	}
+
+	switch e := equalerOf(eq).(type) {
+	case nil:
+		m := make(map[T]int, len(items))
+		for _, t := range items {
+			i, ok := m[t]
+			if !ok {
+				i = -1
+			}
+			m[t] = count(t, i)
+		}
+	case Hasher[T]:
+		m := make(map[uint64][]int)
+		for _, t := range items {
+			h := e.Hash(t)
+			i := slices.IndexFunc(m[h], func(k int) bool { return e.Equal(keys[k], t) })
+			if i < 0 {
+				m[h] = append(m[h], count(t, -1))
+				continue
+			}
+			count(t, m[h][i])
+		}
+	default:
+		for _, t := range items {
+			count(t, slices.IndexFunc(keys, func(k T) bool { return e.Equal(k, t) }))
+		}
+	}
+
+	s := make([]T, 0)
+	for i, t := range keys {
+		if counts[i] > 1 {
+			s = append(s, t)
+		}
+	}
+
+	return sp.end(s)
+}
+
+// DistinctCount returns number of distinct elements.
+// Elements are compared by eq, Equal method of T or == in this order.
+func (l *List[T]) DistinctCount(eq ...Equaler[T]) int {
+	if equalerOf(eq) == nil {
+		m := make(map[T]struct{}, len(l.items()))
+		for _, t := range l.items() {
+			m[t] = struct{}{}
+		}
+		return len(m)
+	}
+
+	return l.Distinct(eq...).Count()
+}
@@ -0,0 +1,145 @@
+package linq
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCountBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []string
+		want  []KeyValue[string, int]
+	}{
+		{
+			name:  "count by first letter",
+			slice: []string{"banana", "apple", "blueberry", "avocado", "cherry", "bean"},
+			want:  []KeyValue[string, int]{{"b", 3}, {"a", 2}, {"c", 1}},
+		},
+		{
+			name:  "empty list",
+			slice: []string{},
+			want:  []KeyValue[string, int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CountBy(From(tt.slice), func(value string, index int) string { return value[:1] })
+			if !reflect.DeepEqual(got.ToList().ToSlice(), tt.want) {
+				t.Errorf("CountBy() = %v, want %v", got.ToList(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCountBy_Get(t *testing.T) {
+	got := CountBy(From([]T{1, 2, 3, 4, 5}), func(value T, index int) bool { return value%2 == 0 })
+	if n, ok := got.TryGet(false); n != 3 || !ok {
+		t.Errorf("TryGet() = %v, %v, want %v", n, ok, 3)
+	}
+}
+
+func TestDistinctBy(t *testing.T) {
+	type args struct {
+		keep []Keep
+	}
+	slice := []string{"apple", "Banana", "APPLE", "cherry", "banana"}
+	tests := []struct {
+		name string
+		args args
+		want *List[string]
+	}{
+		{
+			name: "keep first by default",
+			args: args{},
+			want: &List[string]{slice: []string{"apple", "Banana", "cherry"}},
+		},
+		{
+			name: "keep first",
+			args: args{keep: []Keep{KeepFirst}},
+			want: &List[string]{slice: []string{"apple", "Banana", "cherry"}},
+		},
+		{
+			name: "keep last",
+			args: args{keep: []Keep{KeepLast}},
+			want: &List[string]{slice: []string{"APPLE", "cherry", "banana"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistinctBy(From(slice), func(value string, index int) string { return strings.ToLower(value) }, tt.args.keep...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DistinctBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_Duplicates(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []T
+		want  *List[T]
+	}{
+		{
+			name:  "duplicates in order of first appearance",
+			slice: []T{3, 1, 2, 1, 3, 3, 4},
+			want:  &List[T]{slice: []T{3, 1}},
+		},
+		{
+			name:  "no duplicate",
+			slice: []T{1, 2, 3},
+			want:  &List[T]{slice: []T{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := From(tt.slice).Duplicates(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Duplicates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_Duplicates_Equaler(t *testing.T) {
+	words := From([]string{"Go", "linq", "GO", "go", "Linq", "list"})
+	if got, want := words.Duplicates(StringFold), (&List[string]{slice: []string{"Go", "linq"}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Duplicates() = %v, want %v", got, want)
+	}
+	if got, want := words.Duplicates(EqualerFunc[string](strings.EqualFold)), words.Duplicates(StringFold); !reflect.DeepEqual(got, want) {
+		t.Errorf("Duplicates() = %v, want %v", got, want)
+	}
+
+	versions := From([]Version{{1, 0}, {2, 0}, {1, 1}, {3, 0}})
+	if got, want := versions.Duplicates(), (&List[Version]{slice: []Version{{1, 0}}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Duplicates() = %v, want %v", got, want)
+	}
+}
+
+func TestDistinctBy_Trace(t *testing.T) {
+	var b bytes.Buffer
+	l := From([]T{1, 2, 1, 3, 3}).Trace(traceLogger(&b))
+
+	got := DistinctBy(l, func(v T, i int) T { return v }, KeepLast).Duplicates()
+	if got.Count() != 0 {
+		t.Errorf("Duplicates() = %v, want empty list", got)
+	}
+	want := "level=DEBUG msg=linq op=DistinctBy in=5 out=3\n" +
+		"level=DEBUG msg=linq op=Duplicates in=3 out=0\n"
+	if b.String() != want {
+		t.Errorf("Trace() logged %v, want %v", b.String(), want)
+	}
+}
+
+func TestList_DistinctCount(t *testing.T) {
+	if got := From([]T{1, 2, 1, 3, 2}).DistinctCount(); got != 3 {
+		t.Errorf("DistinctCount() = %v, want %v", got, 3)
+	}
+	if got := From([]string{"a", "A", "b"}).DistinctCount(StringFold); got != 2 {
+		t.Errorf("DistinctCount() = %v, want %v", got, 2)
+	}
+	if got := (*List[T])(nil).DistinctCount(); got != 0 {
+		t.Errorf("DistinctCount() = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,89 @@
+package linq
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// FormatLimit is number of elements printed by Format.
+var FormatLimit = 10
+
+// Format implements fmt.Formatter.
+// Elements are formatted by verb, flags, width and precision, for example %.2f, and lists longer
+// than FormatLimit are truncated like [1 2 3 ... (1000 items)].
+func (l *List[T]) Format(f fmt.State, verb rune) {
+	format := "%"
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			format += string(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		format += fmt.Sprint(width)
+	}
+	if precision, ok := f.Precision(); ok {
+		format += "." + fmt.Sprint(precision)
+	}
+	format += string(verb)
+
+	s := l.items()
+	fmt.Fprint(f, "[")
+	for i, t := range s {
+		if i >= FormatLimit {
+			fmt.Fprintf(f, " ... (%v items)", len(s))
+			break
+		}
+		if i > 0 {
+			fmt.Fprint(f, " ")
+		}
+		fmt.Fprintf(f, format, t)
+	}
+	fmt.Fprint(f, "]")
+}
+
+// String returns elements formatted by %v.
+func (l *List[T]) String() string {
+	return fmt.Sprintf("%v", l)
+}
+
+// Tap calls f for each element and returns the same elements.
+func (l *List[T]) Tap(f func(value T, index int)) *List[T] {
+	sp := l.span("Tap")
+	for i, t := range l.items() {
+		f(t, i)
+	}
+	return sp.end(l.items())
+}
+
+// Trace returns list which logs each operator applied to it and its results.
+// Operator name, input and output counts and elapsed time are logged at debug level.
+// If logger is nil, then tracing is disabled.
+func (l *List[T]) Trace(logger *slog.Logger) *List[T] {
+	return &List[T]{slice: l.items(), logger: logger}
+}
+
+// span is operator call which is being traced.
+// It is zero value when List is not traced.
+type span[T comparable] struct {
+	logger *slog.Logger
+	op     string
+	in     int
+	start  time.Time
+}
+
+func (l *List[T]) span(op string) span[T] {
+	if l == nil || l.logger == nil {
+		return span[T]{}
+	}
+	return span[T]{logger: l.logger, op: op, in: len(l.slice), start: time.Now()}
+}
+
+// end returns List of s which keeps tracing, and logs the operator.
+func (sp span[T]) end(s []T) *List[T] {
+	if sp.logger == nil {
+		return From(s)
+	}
+	sp.logger.Debug("linq", "op", sp.op, "in", sp.in, "out", len(s), "elapsed", time.Since(sp.start))
+	return &List[T]{slice: s, logger: sp.logger}
+}
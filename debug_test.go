@@ -0,0 +1,150 @@
+package linq
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestList_Format(t *testing.T) {
+	long := make([]int, 1000)
+	for i := range long {
+		long[i] = i + 1
+	}
+	tests := []struct {
+		name   string
+		list   *List[int]
+		format string
+		want   string
+	}{
+		{
+			name:   "short list",
+			list:   From([]int{1, 2, 3}),
+			format: "%v",
+			want:   "[1 2 3]",
+		},
+		{
+			name:   "long list",
+			list:   From(long),
+			format: "%v",
+			want:   "[1 2 3 4 5 6 7 8 9 10 ... (1000 items)]",
+		},
+		{
+			name:   "width and precision",
+			list:   From([]int{1, 22}),
+			format: "%3.2d",
+			want:   "[ 01  22]",
+		},
+		{
+			name:   "verb and flags",
+			list:   From([]int{1, 255}),
+			format: "%03x",
+			want:   "[001 0ff]",
+		},
+		{
+			name:   "empty list",
+			list:   From([]int{}),
+			format: "%v",
+			want:   "[]",
+		},
+		{
+			name:   "nil list",
+			list:   nil,
+			format: "%v",
+			want:   "[]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.list); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, want := fmt.Sprintf("%.2f", From([]float64{1, 2.5, 1.0 / 3})), "[1.00 2.50 0.33]"; got != want {
+		t.Errorf("Format() = %v, want %v", got, want)
+	}
+}
+
+func TestList_Format_Limit(t *testing.T) {
+	defer func(limit int) { FormatLimit = limit }(FormatLimit)
+	FormatLimit = 3
+
+	if got, want := fmt.Sprintf("%.1f", From([]float64{1, 2, 3, 4})), "[1.0 2.0 3.0 ... (4 items)]"; got != want {
+		t.Errorf("Format() = %v, want %v", got, want)
+	}
+}
+
+func TestList_String(t *testing.T) {
+	l := From([]string{"a", "b"})
+	if got := l.String(); got != "[a b]" {
+		t.Errorf("String() = %v, want %v", got, "[a b]")
+	}
+	if got := fmt.Sprint(l.OrderBy(strings.Compare)); got != "[a b]" {
+		t.Errorf("Sprint() = %v, want %v", got, "[a b]")
+	}
+}
+
+func TestList_Tap(t *testing.T) {
+	var values, indexes []int
+	got := From([]int{1, 2, 3}).Tap(func(value int, index int) {
+		values = append(values, value)
+		indexes = append(indexes, index)
+	})
+	if want := From([]int{1, 2, 3}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tap() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(values, []int{1, 2, 3}) || !reflect.DeepEqual(indexes, []int{0, 1, 2}) {
+		t.Errorf("Tap() called with %v, %v", values, indexes)
+	}
+}
+
+// traceLogger returns debug logger which writes to b without time and elapsed time.
+func traceLogger(b *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewTextHandler(b, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == "elapsed" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+func TestList_Trace(t *testing.T) {
+	var b bytes.Buffer
+	logger := traceLogger(&b)
+
+	got := From([]int{5, 1, 4, 2, 3}).
+		Trace(logger).
+		Where(func(value int, index int) bool { return value > 1 }).
+		OrderBy(func(a, b int) int { return a - b }).
+		Take(2).
+		ToSlice()
+	if want := []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Trace() = %v, want %v", got, want)
+	}
+
+	want := "level=DEBUG msg=linq op=Where in=5 out=4\n" +
+		"level=DEBUG msg=linq op=OrderBy in=4 out=4\n" +
+		"level=DEBUG msg=linq op=Take in=4 out=2\n"
+	if b.String() != want {
+		t.Errorf("Trace() logged %v, want %v", b.String(), want)
+	}
+}
+
+func TestList_Trace_Disabled(t *testing.T) {
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, nil))
+
+	From([]int{1, 2, 3}).Trace(logger).Skip(1)
+	From([]int{1, 2, 3}).Trace(nil).Skip(1)
+	if b.Len() > 0 {
+		t.Errorf("Trace() logged %v, want nothing", b.String())
+	}
+}
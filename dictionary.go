@@ -0,0 +1,150 @@
+package linq
+
+// KeyValue is pair of key and value.
+type KeyValue[K comparable, V comparable] struct {
+	Key   K
+	Value V
+}
+
+// Dictionary is immutable map which keeps insertion order of keys.
+type Dictionary[K comparable, V comparable] struct {
+	m     map[K]int
+	slice []KeyValue[K, V]
+}
+
+// NewDictionary is constructor of Dictionary.
+// If a key appears more than once, then the last value is kept.
+func NewDictionary[K comparable, V comparable](pairs ...KeyValue[K, V]) *Dictionary[K, V] {
+	d := &Dictionary[K, V]{
+		m:     make(map[K]int, len(pairs)),
+		slice: make([]KeyValue[K, V], 0, len(pairs)),
+	}
+	for _, p := range pairs {
+		if i, ok := d.m[p.Key]; ok {
+			d.slice[i] = p
+			continue
+		}
+		d.m[p.Key] = len(d.slice)
+		d.slice = append(d.slice, p)
+	}
+
+	return d
+}
+
+// ToDictionary returns dictionary of elements by key and value selectors.
+// If a key appears more than once, then the last value is kept.
+func ToDictionary[T comparable, K comparable, V comparable](l *List[T], key func(value T, index int) K, value func(value T, index int) V) *Dictionary[K, V] {
+	pairs := make([]KeyValue[K, V], len(l.items()))
+	for i, t := range l.items() {
+		pairs[i] = KeyValue[K, V]{Key: key(t, i), Value: value(t, i)}
+	}
+
+	return NewDictionary(pairs...)
+}
+
+// items returns pairs of dictionary. Nil dictionary is empty.
+func (d *Dictionary[K, V]) items() []KeyValue[K, V] {
+	if d == nil {
+		return nil
+	}
+	return d.slice
+}
+
+// index returns position of key in pairs.
+func (d *Dictionary[K, V]) index(key K) (int, bool) {
+	if d == nil {
+		return 0, false
+	}
+	i, ok := d.m[key]
+	return i, ok
+}
+
+// ToList returns list of key value pairs in insertion order
+func (d *Dictionary[K, V]) ToList() *List[KeyValue[K, V]] {
+	items := d.items()
+	return From(items[:len(items):len(items)])
+}
+
+// First gets first pair of Dictionary.
+// If pair is not found, then it returns error.
+func (d *Dictionary[K, V]) First(filter ...func(value KeyValue[K, V], index int) bool) (KeyValue[K, V], error) {
+	return d.ToList().First(filter...)
+}
+
+// Where returns condition matched pairs
+func (d *Dictionary[K, V]) Where(f func(value KeyValue[K, V], index int) bool) *List[KeyValue[K, V]] {
+	return d.ToList().Where(f)
+}
+
+// All returns true if all pairs are matched
+func (d *Dictionary[K, V]) All(f func(value KeyValue[K, V], index int) bool) bool {
+	return d.ToList().All(f)
+}
+
+// Any returns true if there is matched pair
+func (d *Dictionary[K, V]) Any(f ...func(value KeyValue[K, V], index int) bool) bool {
+	return d.ToList().Any(f...)
+}
+
+// Contains returns true if dictionary has the pair.
+// Without eq, it looks up key of the pair by ==.
+func (d *Dictionary[K, V]) Contains(pair KeyValue[K, V], eq ...Equaler[KeyValue[K, V]]) bool {
+	if len(eq) > 0 {
+		return d.ToList().Contains(pair, eq...)
+	}
+
+	v, ok := d.TryGet(pair.Key)
+	return ok && v == pair.Value
+}
+
+// Count returns number of pair
+func (d *Dictionary[K, V]) Count(f ...func(value KeyValue[K, V], index int) bool) int {
+	return d.ToList().Count(f...)
+}
+
+// TryGet returns value of key.
+// If key is not found, then it returns false.
+func (d *Dictionary[K, V]) TryGet(key K) (V, bool) {
+	i, ok := d.index(key)
+	if !ok {
+		return *new(V), false
+	}
+
+	return d.slice[i].Value, true
+}
+
+// ContainsKey returns true if dictionary has key
+func (d *Dictionary[K, V]) ContainsKey(key K) bool {
+	_, ok := d.index(key)
+	return ok
+}
+
+// Keys returns list of keys in insertion order
+func (d *Dictionary[K, V]) Keys() *List[K] {
+	s := make([]K, len(d.items()))
+	for i, p := range d.items() {
+		s[i] = p.Key
+	}
+
+	return From(s)
+}
+
+// Values returns list of values in insertion order
+func (d *Dictionary[K, V]) Values() *List[V] {
+	return values(d.items())
+}
+
+// Add returns dictionary with key set to value.
+// If key already exists, then its value is replaced.
+func (d *Dictionary[K, V]) Add(key K, value V) *Dictionary[K, V] {
+	items := d.items()
+	return NewDictionary(append(items[:len(items):len(items)], KeyValue[K, V]{Key: key, Value: value})...)
+}
+
+// Remove returns dictionary without keys
+func (d *Dictionary[K, V]) Remove(keys ...K) *Dictionary[K, V] {
+	removed := NewSet(keys...)
+	return NewDictionary(d.Where(func(value KeyValue[K, V], index int) bool {
+		return !removed.Contains(value.Key)
+	}).slice...)
+}
@@ -0,0 +1,127 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+type kv = KeyValue[string, T]
+
+func TestToDictionary(t *testing.T) {
+	l := From([]T{1, 2, 3, 12})
+	got := ToDictionary(l, func(value T, index int) string {
+		if value%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}, func(value T, index int) T {
+		return value
+	})
+
+	want := []kv{{"odd", 3}, {"even", 12}}
+	if !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("ToDictionary() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+}
+
+func TestDictionary_TryGet(t *testing.T) {
+	d := NewDictionary(kv{"a", 1}, kv{"b", 2})
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   T
+		wantOK bool
+	}{
+		{
+			name: "existing key",
+			args: args{
+				key: "b",
+			},
+			want:   2,
+			wantOK: true,
+		},
+		{
+			name: "missing key",
+			args: args{
+				key: "c",
+			},
+			want:   0,
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := d.TryGet(tt.args.key)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("TryGet() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+			if ok := d.ContainsKey(tt.args.key); ok != tt.wantOK {
+				t.Errorf("ContainsKey() = %v, want %v", ok, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDictionary_Add(t *testing.T) {
+	d := NewDictionary(kv{"a", 1}, kv{"b", 2})
+	got := d.Add("a", 10).Add("c", 3)
+
+	want := []kv{{"a", 10}, {"b", 2}, {"c", 3}}
+	if !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("Add() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+	if v, _ := d.TryGet("a"); v != 1 {
+		t.Errorf("Add() changed original dictionary")
+	}
+}
+
+func TestDictionary_Remove(t *testing.T) {
+	d := NewDictionary(kv{"a", 1}, kv{"b", 2}, kv{"c", 3})
+	got := d.Remove("a", "c", "x")
+
+	if want := []kv{{"b", 2}}; !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("Remove() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+	if d.Count() != 3 {
+		t.Errorf("Remove() changed original dictionary")
+	}
+}
+
+func TestDictionary_Query(t *testing.T) {
+	d := NewDictionary(kv{"a", 1}, kv{"b", 2}, kv{"c", 3})
+	odd := func(value kv, index int) bool {
+		return value.Value%2 == 1
+	}
+
+	if got := d.Where(odd).ToSlice(); !reflect.DeepEqual(got, []kv{{"a", 1}, {"c", 3}}) {
+		t.Errorf("Where() = %v", got)
+	}
+	if got := d.Count(odd); got != 2 {
+		t.Errorf("Count() = %v, want %v", got, 2)
+	}
+	if got, err := d.First(); err != nil || got != (kv{"a", 1}) {
+		t.Errorf("First() = %v, %v", got, err)
+	}
+	if !d.Contains(kv{"b", 2}) || d.Contains(kv{"b", 3}) {
+		t.Errorf("Contains() returned unexpected result")
+	}
+	if !d.Any(odd) || d.All(odd) {
+		t.Errorf("Any() or All() returned unexpected result")
+	}
+	if got := d.Keys().ToSlice(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+	if got := d.Values().ToSlice(); !reflect.DeepEqual(got, []T{1, 2, 3}) {
+		t.Errorf("Values() = %v", got)
+	}
+}
+
+func TestDictionary_RoundTrip(t *testing.T) {
+	d := NewDictionary(kv{"a", 1}, kv{"b", 2})
+	if got := NewDictionary(d.ToList().ToSlice()...); !reflect.DeepEqual(got, d) {
+		t.Errorf("NewDictionary() = %v, want %v", got, d)
+	}
+}
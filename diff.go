@@ -0,0 +1,315 @@
+package linq
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Update is element whose key is in both lists but whose value changed.
+type Update[T comparable] struct {
+	Old T
+	New T
+}
+
+// Patch is difference between two lists whose elements are identified by key.
+type Patch[T comparable, K comparable] struct {
+	// Inserts is elements whose key is only in new list, in order of new list.
+	Inserts *List[T]
+	// Deletes is elements whose key is only in old list, in order of old list.
+	Deletes *List[T]
+	// Updates is changed elements, in order of new list.
+	Updates *List[Update[T]]
+
+	key     func(value T, index int) K
+	order   []K
+	changed map[K]T
+	deleted map[K]struct{}
+}
+
+// Diff returns inserts, deletes and updates which turn old into new.
+// Elements are identified by key, which should be unique in each list.
+// Elements with the same key are compared by eq, Equal method of T or == in this order.
+func Diff[T comparable, K comparable](old, new *List[T], key func(value T, index int) K, eq ...Equaler[T]) *Patch[T, K] {
+	same := sameFunc(eq)
+	oldByKey := make(map[K]T, len(old.items()))
+	for i, t := range old.items() {
+		oldByKey[key(t, i)] = t
+	}
+
+	p := &Patch[T, K]{
+		key:     key,
+		order:   make([]K, len(new.items())),
+		changed: make(map[K]T),
+		deleted: make(map[K]struct{}),
+	}
+	inserts := make([]T, 0)
+	updates := make([]Update[T], 0)
+	newKeys := make(map[K]struct{}, len(new.items()))
+	for i, t := range new.items() {
+		k := key(t, i)
+		p.order[i] = k
+		newKeys[k] = struct{}{}
+		o, ok := oldByKey[k]
+		switch {
+		case !ok:
+			inserts = append(inserts, t)
+			p.changed[k] = t
+		case !same(o, t):
+			updates = append(updates, Update[T]{Old: o, New: t})
+			p.changed[k] = t
+		}
+	}
+	deletes := make([]T, 0)
+	for i, t := range old.items() {
+		k := key(t, i)
+		if _, ok := newKeys[k]; !ok {
+			deletes = append(deletes, t)
+			p.deleted[k] = struct{}{}
+		}
+	}
+	p.Inserts, p.Deletes, p.Updates = From(inserts), From(deletes), From(updates)
+
+	return p
+}
+
+// Apply returns new list of Diff reproduced from old.
+// Unchanged elements are taken from old.
+// If old does not have the elements which the patch was made from, then it returns error.
+func (p *Patch[T, K]) Apply(old *List[T]) (*List[T], error) {
+	oldByKey := make(map[K]T, len(old.items()))
+	for i, t := range old.items() {
+		oldByKey[p.key(t, i)] = t
+	}
+	for k := range p.deleted {
+		if _, ok := oldByKey[k]; !ok {
+			return nil, fmt.Errorf("patch does not apply: deleted key %v not found", k)
+		}
+	}
+
+	s := make([]T, len(p.order))
+	used := 0
+	for i, k := range p.order {
+		o, inOld := oldByKey[k]
+		if t, ok := p.changed[k]; ok {
+			s[i] = t
+		} else if inOld {
+			s[i] = o
+		} else {
+			return nil, fmt.Errorf("patch does not apply: key %v not found", k)
+		}
+		if inOld {
+			used++
+		}
+	}
+	if used+len(p.deleted) != len(oldByKey) {
+		return nil, fmt.Errorf("patch does not apply: unexpected elements in list")
+	}
+
+	return From(s), nil
+}
+
+// EditOp is kind of Edit.
+type EditOp int
+
+const (
+	// EditEqual keeps element of old list.
+	EditEqual EditOp = iota
+	// EditInsert inserts element of new list.
+	EditInsert
+	// EditDelete deletes element of old list.
+	EditDelete
+)
+
+// Edit is a step of EditScript.
+type Edit[T comparable] struct {
+	Op EditOp
+	// OldIndex is index in old list. It is -1 for EditInsert.
+	OldIndex int
+	// NewIndex is index in new list. It is -1 for EditDelete.
+	NewIndex int
+	Value    T
+}
+
+// EditScript is shortest sequence of edits which turns a list into another.
+type EditScript[T comparable] []Edit[T]
+
+// EditScript returns shortest edit script which turns l into other by Myers' algorithm.
+// It uses the linear space variant, which splits the lists at the middle snake of
+// the shortest path, so memory is proportional to length of lists.
+// It explains why SequenceEqual returns false, and is empty of insertions and deletions if it returns true.
+// Elements are compared by eq, Equal method of T or == in this order.
+func (l *List[T]) EditScript(other *List[T], eq ...Equaler[T]) EditScript[T] {
+	a, b := l.items(), other.items()
+	size := len(a) + len(b) + 2
+	d := &differ[T]{
+		a:      a,
+		b:      b,
+		same:   sameFunc(eq),
+		script: make(EditScript[T], 0, len(a)+len(b)),
+		vf:     make([]int, 2*size+1),
+		vb:     make([]int, 2*size+1),
+		offset: size,
+	}
+	d.diff(0, len(a), 0, len(b))
+
+	return d.script
+}
+
+// differ builds EditScript between a and b.
+type differ[T comparable] struct {
+	a, b   []T
+	same   func(a, b T) bool
+	script EditScript[T]
+	// vf and vb are furthest reaching x of each diagonal of forward and backward paths.
+	vf, vb []int
+	offset int
+}
+
+func (d *differ[T]) equal(x, y int) {
+	d.script = append(d.script, Edit[T]{Op: EditEqual, OldIndex: x, NewIndex: y, Value: d.a[x]})
+}
+
+// diff appends edits which turn a[aLo:aHi] into b[bLo:bHi].
+func (d *differ[T]) diff(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.same(d.a[aLo], d.b[bLo]) {
+		d.equal(aLo, bLo)
+		aLo, bLo = aLo+1, bLo+1
+	}
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.same(d.a[aHi-suffix-1], d.b[bHi-suffix-1]) {
+		suffix++
+	}
+	aHi, bHi = aHi-suffix, bHi-suffix
+
+	switch {
+	case aLo == aHi:
+		for y := bLo; y < bHi; y++ {
+			d.script = append(d.script, Edit[T]{Op: EditInsert, OldIndex: -1, NewIndex: y, Value: d.b[y]})
+		}
+	case bLo == bHi:
+		for x := aLo; x < aHi; x++ {
+			d.script = append(d.script, Edit[T]{Op: EditDelete, OldIndex: x, NewIndex: -1, Value: d.a[x]})
+		}
+	default:
+		x, y, u, v := d.middleSnake(aLo, aHi, bLo, bHi)
+		d.diff(aLo, x, bLo, y)
+		for ; x < u; x, y = x+1, y+1 {
+			d.equal(x, y)
+		}
+		d.diff(u, aHi, v, bHi)
+	}
+
+	for i := 0; i < suffix; i++ {
+		d.equal(aHi+i, bHi+i)
+	}
+}
+
+// middleSnake returns start (x, y) and end (u, v) of the snake in the middle of the shortest
+// path from (aLo, bLo) to (aHi, bHi), by searching forward and backward at the same time.
+func (d *differ[T]) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	vf, vb, o := d.vf, d.vb, d.offset
+	vf[o+1], vb[o+1] = 0, 0
+
+	for e := 0; e <= (n+m+1)/2; e++ {
+		for k := -e; k <= e; k += 2 {
+			x := vf[o+k-1] + 1
+			if k == -e || k != e && vf[o+k-1] < vf[o+k+1] {
+				x = vf[o+k+1]
+			}
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && d.same(d.a[aLo+x], d.b[bLo+y]) {
+				x, y = x+1, y+1
+			}
+			vf[o+k] = x
+			// Backward path on diagonal delta-k has made e-1 edits.
+			if c := delta - k; odd && c >= -(e-1) && c <= e-1 && x+vb[o+c] >= n {
+				return aLo + x0, bLo + y0, aLo + x, bLo + y
+			}
+		}
+		for k := -e; k <= e; k += 2 {
+			x := vb[o+k-1] + 1
+			if k == -e || k != e && vb[o+k-1] < vb[o+k+1] {
+				x = vb[o+k+1]
+			}
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && d.same(d.a[aHi-1-x], d.b[bHi-1-y]) {
+				x, y = x+1, y+1
+			}
+			vb[o+k] = x
+			// Forward path on diagonal delta-k has made e edits.
+			if c := delta - k; !odd && c >= -e && c <= e && x+vf[o+c] >= n {
+				return aHi - x, bHi - y, aHi - x0, bHi - y0
+			}
+		}
+	}
+
+	panic("linq: middle snake not found")
+}
+
+// Distance returns number of insertions and deletions.
+func (s EditScript[T]) Distance() int {
+	n := 0
+	for _, e := range s {
+		if e.Op != EditEqual {
+			n++
+		}
+	}
+	return n
+}
+
+// String returns edits as lines prefixed by " ", "+" or "-".
+func (s EditScript[T]) String() string {
+	var b strings.Builder
+	for _, e := range s {
+		switch e.Op {
+		case EditEqual:
+			b.WriteString(" ")
+		case EditInsert:
+			b.WriteString("+")
+		case EditDelete:
+			b.WriteString("-")
+		}
+		fmt.Fprintf(&b, "%v\n", e.Value)
+	}
+	return b.String()
+}
+
+// Apply returns new list of the script reproduced from old.
+// If old does not have the kept and deleted elements of the script, then it returns error.
+func (s EditScript[T]) Apply(old *List[T]) (*List[T], error) {
+	a := old.items()
+	r := make([]T, 0, len(s))
+	i := 0
+	for _, e := range s {
+		if e.Op == EditInsert {
+			r = append(r, e.Value)
+			continue
+		}
+		if i >= len(a) || a[i] != e.Value {
+			return nil, fmt.Errorf("script does not apply at index %v", i)
+		}
+		if e.Op == EditEqual {
+			r = append(r, a[i])
+		}
+		i++
+	}
+	if i != len(a) {
+		return nil, fmt.Errorf("script does not apply at index %v", i)
+	}
+
+	return From(r), nil
+}
+
+// sameFunc returns function which compares elements by eq, Equal method of T or == in this order.
+func sameFunc[T comparable](eq []Equaler[T]) func(a, b T) bool {
+	e := equalerOf(eq)
+	if e == nil {
+		return func(a, b T) bool { return a == b }
+	}
+	return e.Equal
+}
@@ -0,0 +1,211 @@
+package linq
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+type Config struct {
+	Name  string
+	Value string
+}
+
+func configName(value Config, index int) string {
+	return value.Name
+}
+
+func TestDiff(t *testing.T) {
+	old := From([]Config{{"a", "1"}, {"b", "2"}, {"c", "3"}})
+	new := From([]Config{{"c", "3"}, {"a", "10"}, {"d", "4"}})
+
+	p := Diff(old, new, configName)
+	if want := (&List[Config]{slice: []Config{{"d", "4"}}}); !reflect.DeepEqual(p.Inserts, want) {
+		t.Errorf("Diff() inserts = %v, want %v", p.Inserts, want)
+	}
+	if want := (&List[Config]{slice: []Config{{"b", "2"}}}); !reflect.DeepEqual(p.Deletes, want) {
+		t.Errorf("Diff() deletes = %v, want %v", p.Deletes, want)
+	}
+	if want := (&List[Update[Config]]{slice: []Update[Config]{{Old: Config{"a", "1"}, New: Config{"a", "10"}}}}); !reflect.DeepEqual(p.Updates, want) {
+		t.Errorf("Diff() updates = %v, want %v", p.Updates, want)
+	}
+
+	got, err := p.Apply(old)
+	if err != nil || !got.SequenceEqual(new) {
+		t.Errorf("Apply() = %v, %v, want %v", got, err, new)
+	}
+}
+
+func TestDiff_Equaler(t *testing.T) {
+	old := From([]string{"Go", "Rust"})
+	new := From([]string{"GO", "rust", "Zig"})
+	p := Diff(old, new, func(value string, index int) string { return strings.ToLower(value) }, StringFold)
+	if p.Updates.Count() != 0 || p.Inserts.Count() != 1 || p.Deletes.Count() != 0 {
+		t.Errorf("Diff() = %v, %v, %v", p.Inserts, p.Deletes, p.Updates)
+	}
+	got, err := p.Apply(old)
+	if want := []string{"Go", "Rust", "Zig"}; err != nil || !reflect.DeepEqual(got.ToSlice(), want) {
+		t.Errorf("Apply() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestPatch_Apply_Error(t *testing.T) {
+	old := From([]Config{{"a", "1"}, {"b", "2"}})
+	p := Diff(old, From([]Config{{"a", "1"}}), configName)
+	tests := []struct {
+		name string
+		list *List[Config]
+	}{
+		{name: "deleted element is missing", list: From([]Config{{"a", "1"}})},
+		{name: "unchanged element is missing", list: From([]Config{{"b", "2"}})},
+		{name: "unexpected element", list: From([]Config{{"a", "1"}, {"b", "2"}, {"c", "3"}})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := p.Apply(tt.list); err == nil {
+				t.Errorf("Apply() = %v, want error", got)
+			}
+		})
+	}
+}
+
+func TestList_EditScript(t *testing.T) {
+	tests := []struct {
+		name         string
+		old          []string
+		new          []string
+		want         string
+		wantDistance int
+	}{
+		{
+			name:         "myers example",
+			old:          strings.Split("ABCABBA", ""),
+			new:          strings.Split("CBABAC", ""),
+			want:         "-A\n+C\n B\n-C\n A\n B\n-B\n A\n+C\n",
+			wantDistance: 5,
+		},
+		{
+			name:         "same lists",
+			old:          []string{"a", "b"},
+			new:          []string{"a", "b"},
+			want:         " a\n b\n",
+			wantDistance: 0,
+		},
+		{
+			name:         "from empty list",
+			old:          []string{},
+			new:          []string{"a", "b"},
+			want:         "+a\n+b\n",
+			wantDistance: 2,
+		},
+		{
+			name:         "to empty list",
+			old:          []string{"a"},
+			new:          nil,
+			want:         "-a\n",
+			wantDistance: 1,
+		},
+		{
+			name:         "both empty",
+			old:          nil,
+			new:          nil,
+			want:         "",
+			wantDistance: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old, new := From(tt.old), From(tt.new)
+			script := old.EditScript(new)
+			if got := script.String(); got != tt.want {
+				t.Errorf("EditScript() = %q, want %q", got, tt.want)
+			}
+			if got := script.Distance(); got != tt.wantDistance {
+				t.Errorf("Distance() = %v, want %v", got, tt.wantDistance)
+			}
+			if (script.Distance() == 0) != old.SequenceEqual(new) {
+				t.Errorf("Distance() = %v, but SequenceEqual() = %v", script.Distance(), old.SequenceEqual(new))
+			}
+			got, err := script.Apply(old)
+			if err != nil || !got.SequenceEqual(new) {
+				t.Errorf("Apply() = %v, %v, want %v", got, err, new)
+			}
+		})
+	}
+}
+
+func TestEditScript_Indexes(t *testing.T) {
+	script := From([]T{1, 2, 3}).EditScript(From([]T{1, 4, 3}))
+	want := EditScript[T]{
+		{Op: EditEqual, OldIndex: 0, NewIndex: 0, Value: 1},
+		{Op: EditDelete, OldIndex: 1, NewIndex: -1, Value: 2},
+		{Op: EditInsert, OldIndex: -1, NewIndex: 1, Value: 4},
+		{Op: EditEqual, OldIndex: 2, NewIndex: 2, Value: 3},
+	}
+	if !reflect.DeepEqual(script, want) {
+		t.Errorf("EditScript() = %v, want %v", script, want)
+	}
+	if _, err := script.Apply(From([]T{1, 5, 3})); err == nil {
+		t.Errorf("Apply() error = nil, want error")
+	}
+}
+
+// lcsLength returns length of longest common subsequence by dynamic programming.
+func lcsLength(a, b []byte) int {
+	prev := make([]int, len(b)+1)
+	for i := range a {
+		cur := make([]int, len(b)+1)
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+func TestList_EditScript_Memory(t *testing.T) {
+	if testing.CoverMode() != "" {
+		t.Skip("allocations differ with coverage")
+	}
+	a, b := make([]int, 3000), make([]int, 3000)
+	for i := range a {
+		a[i], b[i] = i, -i-1
+	}
+	old, new := From(a), From(b)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	script := old.EditScript(new)
+	runtime.ReadMemStats(&after)
+	if script.Distance() != 6000 {
+		t.Errorf("Distance() = %v, want %v", script.Distance(), 6000)
+	}
+	if got := after.TotalAlloc - before.TotalAlloc; got > 4<<20 {
+		t.Errorf("EditScript() allocated %v bytes, want at most %v", got, 4<<20)
+	}
+}
+
+func FuzzList_EditScript(f *testing.F) {
+	f.Add([]byte("ABCABBA"), []byte("CBABAC"))
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		old, new := From(a), From(b)
+		script := old.EditScript(new)
+		got, err := script.Apply(old)
+		if err != nil || !got.SequenceEqual(new) {
+			t.Fatalf("Apply() = %v, %v, want %v", got, err, new)
+		}
+		if lcs := len(script) - script.Distance(); len(a)+len(b)-2*lcs != script.Distance() {
+			t.Fatalf("Distance() = %v is inconsistent with %v equal elements", script.Distance(), lcs)
+		}
+		if len(a) <= 64 && len(b) <= 64 {
+			if want := len(a) + len(b) - 2*lcsLength(a, b); script.Distance() != want {
+				t.Fatalf("Distance() = %v, want shortest %v", script.Distance(), want)
+			}
+		}
+	})
+}
@@ -0,0 +1,443 @@
+package linq
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// RecordError is error of a record which could not be read.
+type RecordError struct {
+	// Index is index of the record in input.
+	Index int
+	// Line is line number of the record in input.
+	Line int
+	// Field is name of the field which could not be read, if known.
+	Field string
+	Err   error
+}
+
+func (e *RecordError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("line %v: field %v: %v", e.Line, e.Field, e.Err)
+	}
+	return fmt.Sprintf("line %v: %v", e.Line, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// RecordErrors is list of RecordError.
+// Readers return it alongside the records which were read successfully.
+type RecordErrors []*RecordError
+
+func (e RecordErrors) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+func (e RecordErrors) Unwrap() []error {
+	s := make([]error, len(e))
+	for i, err := range e {
+		s[i] = err
+	}
+	return s
+}
+
+func (e RecordErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// FromJSON reads JSON array into List.
+// Elements which can not be decoded into T are skipped and reported as RecordErrors.
+// If input is not JSON array, then it returns error.
+func FromJSON[T comparable](r io.Reader) (*List[T], error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('[') {
+		return nil, fmt.Errorf("not a JSON array")
+	}
+
+	s := make([]T, 0)
+	var errs RecordErrors
+	line, pos := 1, 0
+	for i := 0; dec.More(); i++ {
+		start := int(dec.InputOffset())
+		for start < len(data) && strings.IndexByte(" \t\r\n,", data[start]) >= 0 {
+			start++
+		}
+		line += bytes.Count(data[pos:start], []byte("\n"))
+		pos = start
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+
+		var v T
+		if err := json.Unmarshal(raw, &v); err != nil {
+			errs = append(errs, jsonRecordError(i, line, err))
+			continue
+		}
+		s = append(s, v)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return From(s), errs.err()
+}
+
+// FromJSONLines reads JSON Lines into List, decoding one line at a time.
+// Blank lines are ignored.
+// Lines which can not be decoded into T are skipped and reported as RecordErrors.
+func FromJSONLines[T comparable](r io.Reader) (*List[T], error) {
+	seq, errf := FromJSONLinesSeq[T](r)
+	l := seq.ToList()
+	if err := errf(); err != nil {
+		if _, ok := err.(RecordErrors); !ok {
+			return nil, err
+		}
+		return l, err
+	}
+
+	return l, nil
+}
+
+// FromJSONLinesSeq returns lazy sequence of JSON Lines read from r.
+// r is read while the sequence is enumerated, so it can be enumerated only once.
+// Blank lines are ignored, and lines which can not be decoded into T are skipped.
+// The returned function reports read error, or RecordErrors of skipped lines, after enumeration.
+func FromJSONLinesSeq[T comparable](r io.Reader) (*Sequence[T], func() error) {
+	br := bufio.NewReader(r)
+	var readErr error
+	var errs RecordErrors
+	line, i := 1, 0
+	seq := FromSeq(func(yield func(T) bool) {
+		for ; readErr == nil; line++ {
+			b, err := br.ReadBytes('\n')
+			if err != nil {
+				readErr = err
+				if err != io.EOF {
+					return
+				}
+			}
+
+			if len(bytes.TrimSpace(b)) > 0 {
+				var v T
+				err := json.Unmarshal(b, &v)
+				if err != nil {
+					errs = append(errs, jsonRecordError(i, line, err))
+				}
+				i++
+				if err == nil && !yield(v) {
+					line++
+					return
+				}
+			}
+		}
+	})
+
+	return seq, func() error {
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+		return errs.err()
+	}
+}
+
+func jsonRecordError(index, line int, err error) *RecordError {
+	e := &RecordError{Index: index, Line: line, Err: err}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		e.Field = typeErr.Field
+	}
+	return e
+}
+
+// FromCSV reads CSV with header row into List of struct.
+// Columns are mapped to fields by `csv:"name"` tag or field name, and unknown columns are ignored.
+// Records which can not be decoded into T are skipped and reported as RecordErrors.
+// If T is not struct or header can not be read, then it returns error.
+func FromCSV[T comparable](r io.Reader) (*List[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	columns, err := taggedFields(t, "csv")
+	if err != nil {
+		return nil, err
+	}
+
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]*taggedField, len(header))
+	for i, name := range header {
+		fields[i] = findField(columns, name)
+	}
+
+	s := make([]T, 0)
+	var errs RecordErrors
+	for i := 0; ; i++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			errs = append(errs, &RecordError{Index: i, Line: parseErr.Line, Err: parseErr.Err})
+			continue
+		}
+
+		var v T
+		rv := reflect.ValueOf(&v).Elem()
+		var recordErr *RecordError
+		for j, value := range record {
+			if fields[j] == nil {
+				continue
+			}
+			if err := setCSVValue(rv.FieldByIndex(fields[j].index), value); err != nil {
+				line, _ := cr.FieldPos(j)
+				recordErr = &RecordError{Index: i, Line: line, Field: fields[j].name, Err: err}
+				break
+			}
+		}
+		if recordErr != nil {
+			errs = append(errs, recordErr)
+			continue
+		}
+		s = append(s, v)
+	}
+
+	return From(s), errs.err()
+}
+
+// WriteJSON writes elements as JSON array.
+func (l *List[T]) WriteJSON(w io.Writer) error {
+	s := l.items()
+	if s == nil {
+		s = []T{}
+	}
+	return json.NewEncoder(w).Encode(s)
+}
+
+// WriteJSONLines writes elements as JSON Lines.
+func (l *List[T]) WriteJSONLines(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, t := range l.items() {
+		if err := enc.Encode(t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteCSV writes elements of struct as CSV with header row.
+// Columns are named by `csv:"name"` tag or field name.
+// If T is not struct, then it returns error.
+func (l *List[T]) WriteCSV(w io.Writer) error {
+	columns, err := taggedFields(reflect.TypeOf((*T)(nil)).Elem(), "csv")
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	record := make([]string, len(columns))
+	for i, c := range columns {
+		record[i] = c.name
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for _, t := range l.items() {
+		rv := reflect.ValueOf(t)
+		for i, c := range columns {
+			if record[i], err = formatCSVValue(rv.FieldByIndex(c.index)); err != nil {
+				return fmt.Errorf("field %v: %w", c.name, err)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+type taggedField struct {
+	name  string
+	index []int
+}
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// taggedFields returns exported fields of struct t named by tag or field name.
+func taggedFields(t reflect.Type, tag string) ([]taggedField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("not a struct: %v", t)
+	}
+
+	var columns []taggedField
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || (len(f.Index) > 1 && !isPromoted(t, f.Index)) {
+			continue
+		}
+		if f.Anonymous && isEmbeddedStruct(f.Type) {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		columns = append(columns, taggedField{name: name, index: f.Index})
+	}
+
+	return columns, nil
+}
+
+// isEmbeddedStruct reports whether embedded field of t only provides promoted fields.
+func isEmbeddedStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// isPromoted reports whether field at index is reachable without dereferencing embedded pointers.
+func isPromoted(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+	}
+	return true
+}
+
+func findField(columns []taggedField, name string) *taggedField {
+	for i := range columns {
+		if columns[i].name == name {
+			return &columns[i]
+		}
+	}
+	for i := range columns {
+		if strings.EqualFold(columns[i].name, name) {
+			return &columns[i]
+		}
+	}
+
+	return nil
+}
+
+func setCSVValue(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Pointer {
+		if s == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+
+	if s == "" {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported type: %v", v.Type())
+	}
+
+	return nil
+}
+
+func formatCSVValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("unsupported type: %v", v.Type())
+	}
+}
@@ -0,0 +1,336 @@
+package linq
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+type Meta struct {
+	Source string `csv:"source"`
+}
+
+type Record struct {
+	Meta
+	Name    string    `json:"name" csv:"name"`
+	Age     int       `json:"age" csv:"age"`
+	Active  bool      `json:"active" csv:"active"`
+	Created time.Time `json:"-" csv:"created"`
+	Note    string    `json:"-" csv:"-"`
+}
+
+func recordErrors(err error) []RecordError {
+	var errs RecordErrors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+	s := make([]RecordError, len(errs))
+	for i, e := range errs {
+		s[i] = RecordError{Index: e.Index, Line: e.Line, Field: e.Field}
+	}
+	return s
+}
+
+func TestFromJSON(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       *List[Record]
+		wantErrs   []RecordError
+		wantFailed bool
+	}{
+		{
+			name: "read array",
+			args: args{
+				input: `[{"name":"a","age":1},{"name":"b","age":2}]`,
+			},
+			want: &List[Record]{
+				slice: []Record{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			},
+		},
+		{
+			name: "empty array",
+			args: args{
+				input: `[]`,
+			},
+			want: &List[Record]{
+				slice: []Record{},
+			},
+		},
+		{
+			name: "report invalid elements",
+			args: args{
+				input: "[\n  {\"name\":\"a\",\"age\":1},\n  {\"name\":\"b\",\"age\":\"x\"},\n  {\"name\":\"c\",\"age\":3},\n  1\n]",
+			},
+			want: &List[Record]{
+				slice: []Record{{Name: "a", Age: 1}, {Name: "c", Age: 3}},
+			},
+			wantErrs: []RecordError{
+				{Index: 1, Line: 3, Field: "age"},
+				{Index: 3, Line: 5, Field: ""},
+			},
+		},
+		{
+			name: "not an array",
+			args: args{
+				input: `{"name":"a"}`,
+			},
+			wantFailed: true,
+		},
+		{
+			name: "syntax error",
+			args: args{
+				input: `[{"name":"a"},`,
+			},
+			wantFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromJSON[Record](strings.NewReader(tt.args.input))
+			if tt.wantFailed {
+				if err == nil || got != nil {
+					t.Errorf("FromJSON() = %v, %v, want failure", got, err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromJSON() = %v, want %v", got, tt.want)
+			}
+			if errs := recordErrors(err); !reflect.DeepEqual(errs, tt.wantErrs) {
+				t.Errorf("FromJSON() errors = %v, want %v", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestFromJSONLines(t *testing.T) {
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     *List[Record]
+		wantErrs []RecordError
+	}{
+		{
+			name: "read lines",
+			args: args{
+				input: "{\"name\":\"a\",\"age\":1}\n\n{\"name\":\"b\",\"age\":2}",
+			},
+			want: &List[Record]{
+				slice: []Record{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			},
+		},
+		{
+			name: "report invalid lines",
+			args: args{
+				input: "{\"name\":\"a\",\"age\":1}\n{\"name\":\n{\"active\":1}\n{\"name\":\"d\"}\n",
+			},
+			want: &List[Record]{
+				slice: []Record{{Name: "a", Age: 1}, {Name: "d"}},
+			},
+			wantErrs: []RecordError{
+				{Index: 1, Line: 2, Field: ""},
+				{Index: 2, Line: 3, Field: "active"},
+			},
+		},
+		{
+			name: "empty input",
+			args: args{
+				input: "",
+			},
+			want: &List[Record]{
+				slice: []Record{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromJSONLines[Record](strings.NewReader(tt.args.input))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromJSONLines() = %v, want %v", got, tt.want)
+			}
+			if errs := recordErrors(err); !reflect.DeepEqual(errs, tt.wantErrs) {
+				t.Errorf("FromJSONLines() errors = %v, want %v", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestFromJSONLinesSeq(t *testing.T) {
+	input := "{\"name\":\"a\"}\n{\"name\":\n{\"name\":\"b\"}\n\n{\"age\":\"x\"}\n{\"name\":\"c\"}"
+	seq, errf := FromJSONLinesSeq[Record](strings.NewReader(input))
+
+	if got := seq.Take(1).ToSlice(); !reflect.DeepEqual(got, []Record{{Name: "a"}}) || errf() != nil {
+		t.Errorf("Take() = %v, %v", got, errf())
+	}
+	if got, want := seq.ToSlice(), []Record{{Name: "b"}, {Name: "c"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	if errs, want := recordErrors(errf()), []RecordError{{Index: 1, Line: 2}, {Index: 3, Line: 5, Field: "age"}}; !reflect.DeepEqual(errs, want) {
+		t.Errorf("FromJSONLinesSeq() errors = %v, want %v", errs, want)
+	}
+}
+
+func TestFromJSONLinesSeq_ReadError(t *testing.T) {
+	errRead := errors.New("read error")
+	seq, errf := FromJSONLinesSeq[Record](io.MultiReader(strings.NewReader("{\"name\":\"a\"}\n"), iotest.ErrReader(errRead)))
+	if got := seq.ToSlice(); !reflect.DeepEqual(got, []Record{{Name: "a"}}) || errf() != errRead {
+		t.Errorf("ToSlice() = %v, %v, want error %v", got, errf(), errRead)
+	}
+	if got, err := FromJSONLines[Record](io.MultiReader(strings.NewReader("{}\n"), iotest.ErrReader(errRead))); got != nil || err != errRead {
+		t.Errorf("FromJSONLines() = %v, %v, want error %v", got, err, errRead)
+	}
+}
+
+func TestFromCSV(t *testing.T) {
+	created := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       *List[Record]
+		wantErrs   []RecordError
+		wantFailed bool
+	}{
+		{
+			name: "read records",
+			args: args{
+				input: "name,Age,active,created,source,unknown\na,1,true,2022-01-02T03:04:05Z,web,x\nb,,false,,,y\n",
+			},
+			want: &List[Record]{
+				slice: []Record{
+					{Meta: Meta{Source: "web"}, Name: "a", Age: 1, Active: true, Created: created},
+					{Name: "b"},
+				},
+			},
+		},
+		{
+			name: "report invalid records",
+			args: args{
+				input: "name,age\na,1\nb,x\nc\nd,4\n",
+			},
+			want: &List[Record]{
+				slice: []Record{{Name: "a", Age: 1}, {Name: "d", Age: 4}},
+			},
+			wantErrs: []RecordError{
+				{Index: 1, Line: 3, Field: "age"},
+				{Index: 2, Line: 4, Field: ""},
+			},
+		},
+		{
+			name: "no header",
+			args: args{
+				input: "",
+			},
+			wantFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromCSV[Record](strings.NewReader(tt.args.input))
+			if tt.wantFailed {
+				if err == nil || got != nil {
+					t.Errorf("FromCSV() = %v, %v, want failure", got, err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromCSV() = %v, want %v", got, tt.want)
+			}
+			if errs := recordErrors(err); !reflect.DeepEqual(errs, tt.wantErrs) {
+				t.Errorf("FromCSV() errors = %v, want %v", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestFromCSV_NotStruct(t *testing.T) {
+	if _, err := FromCSV[int](strings.NewReader("a\n1\n")); err == nil {
+		t.Errorf("FromCSV() error = nil, want error")
+	}
+}
+
+func TestList_WriteJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []Record
+		want  string
+	}{
+		{
+			name:  "write array",
+			slice: []Record{{Name: "a", Age: 1}, {Name: "b", Age: 2, Active: true}},
+			want:  `[{"Source":"","name":"a","age":1,"active":false},{"Source":"","name":"b","age":2,"active":true}]` + "\n",
+		},
+		{
+			name:  "nil slice",
+			slice: nil,
+			want:  "[]\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := From(tt.slice).WriteJSON(&b); err != nil {
+				t.Errorf("WriteJSON() error = %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("WriteJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_WriteJSONLines(t *testing.T) {
+	var b bytes.Buffer
+	l := From([]Record{{Name: "a", Age: 1}, {Name: "b", Age: 2}})
+	if err := l.WriteJSONLines(&b); err != nil {
+		t.Errorf("WriteJSONLines() error = %v", err)
+	}
+	got, err := FromJSONLines[Record](&b)
+	if err != nil {
+		t.Errorf("FromJSONLines() error = %v", err)
+	}
+	if !got.SequenceEqual(l) {
+		t.Errorf("WriteJSONLines() round trip = %v, want %v", got, l)
+	}
+}
+
+func TestList_WriteCSV(t *testing.T) {
+	created := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	l := From([]Record{
+		{Meta: Meta{Source: "web"}, Name: "a, b", Age: 1, Active: true, Created: created},
+		{Name: "c", Age: 2},
+	})
+
+	var b bytes.Buffer
+	if err := l.WriteCSV(&b); err != nil {
+		t.Errorf("WriteCSV() error = %v", err)
+	}
+	want := "source,name,age,active,created\n" +
+		"web,\"a, b\",1,true,2022-01-02T03:04:05Z\n" +
+		",c,2,false,0001-01-01T00:00:00Z\n"
+	if got := b.String(); got != want {
+		t.Errorf("WriteCSV() = %v, want %v", got, want)
+	}
+
+	got, err := FromCSV[Record](&b)
+	if err != nil {
+		t.Errorf("FromCSV() error = %v", err)
+	}
+	if !got.SequenceEqual(l) {
+		t.Errorf("WriteCSV() round trip = %v, want %v", got, l)
+	}
+}
@@ -0,0 +1,146 @@
+package linq
+
+import (
+	"hash/maphash"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Equaler determines whether two elements are equal.
+type Equaler[T any] interface {
+	Equal(a, b T) bool
+}
+
+// Hasher is Equaler which also hashes elements.
+// Equal elements must have the same hash.
+type Hasher[T any] interface {
+	Equaler[T]
+	Hash(value T) uint64
+}
+
+// Comparer determines order of two elements.
+// Compare returns negative number if a is less than b, positive number if a is greater than b and 0 otherwise.
+type Comparer[T any] interface {
+	Compare(a, b T) int
+}
+
+// EqualerFunc is adapter to use function as Equaler.
+type EqualerFunc[T any] func(a, b T) bool
+
+func (f EqualerFunc[T]) Equal(a, b T) bool {
+	return f(a, b)
+}
+
+// ComparerFunc is adapter to use function as Comparer.
+type ComparerFunc[T any] func(a, b T) int
+
+func (f ComparerFunc[T]) Compare(a, b T) int {
+	return f(a, b)
+}
+
+var seed = maphash.MakeSeed()
+
+// StringFoldComparer compares strings case-insensitively under Unicode case folding.
+// It is Hasher and Comparer.
+type StringFoldComparer struct{}
+
+// StringFold compares strings case-insensitively under Unicode case folding.
+var StringFold StringFoldComparer
+
+func (StringFoldComparer) Equal(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+func (StringFoldComparer) Hash(value string) uint64 {
+	return maphash.String(seed, strings.Map(foldRune, value))
+}
+
+func (StringFoldComparer) Compare(a, b string) int {
+	return strings.Compare(strings.Map(foldRune, a), strings.Map(foldRune, b))
+}
+
+// foldRune returns smallest rune which is equivalent to r under Unicode case folding.
+func foldRune(r rune) rune {
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < folded {
+			folded = f
+		}
+	}
+
+	return folded
+}
+
+// FloatEpsilonComparer compares floats as equal when their difference is not greater than it.
+// It is Equaler and Comparer, but not Hasher because equality within epsilon is not transitive.
+type FloatEpsilonComparer float64
+
+// FloatEpsilon compares floats as equal when their difference is not greater than eps.
+func FloatEpsilon(eps float64) FloatEpsilonComparer {
+	return FloatEpsilonComparer(eps)
+}
+
+func (eps FloatEpsilonComparer) Equal(a, b float64) bool {
+	return math.Abs(a-b) <= float64(eps)
+}
+
+func (eps FloatEpsilonComparer) Compare(a, b float64) int {
+	switch {
+	case eps.Equal(a, b):
+		return 0
+	case a < b:
+		return -1
+	default:
+		return 1
+	}
+}
+
+type byKey[T any, K comparable] func(value T) K
+
+// By compares elements by key, e.g. by ID ignoring other fields.
+func By[T any, K comparable](key func(value T) K) Hasher[T] {
+	return byKey[T, K](key)
+}
+
+func (key byKey[T, K]) Equal(a, b T) bool {
+	return key(a) == key(b)
+}
+
+func (key byKey[T, K]) Hash(value T) uint64 {
+	return maphash.Comparable(seed, key(value))
+}
+
+// equalerOf returns eq[0] or Equaler using Equal method of T.
+// If neither is available, then it returns nil and == should be used.
+func equalerOf[T comparable](eq []Equaler[T]) Equaler[T] {
+	if len(eq) > 0 {
+		return eq[0]
+	}
+
+	var zero T
+	if _, ok := any(zero).(interface{ Equal(T) bool }); ok {
+		return EqualerFunc[T](func(a, b T) bool {
+			return any(a).(interface{ Equal(T) bool }).Equal(b)
+		})
+	}
+
+	return nil
+}
+
+// comparerOf returns c[0] or Comparer using Compare method of T.
+// If neither is available, then it returns nil.
+func comparerOf[T comparable](c []Comparer[T]) Comparer[T] {
+	if len(c) > 0 {
+		return c[0]
+	}
+
+	var zero T
+	if _, ok := any(zero).(interface{ Compare(T) int }); ok {
+		return ComparerFunc[T](func(a, b T) int {
+			return any(a).(interface{ Compare(T) int }).Compare(b)
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+type Version struct {
+	ID       int
+	Revision int
+}
+
+func (v Version) Equal(other Version) bool {
+	return v.ID == other.ID
+}
+
+func (v Version) Compare(other Version) int {
+	return v.ID - other.ID
+}
+
+func TestStringFold(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "same string",
+			args: args{
+				a: "go",
+				b: "go",
+			},
+			want: true,
+		},
+		{
+			name: "different case",
+			args: args{
+				a: "Go",
+				b: "gO",
+			},
+			want: true,
+		},
+		{
+			name: "kelvin sign",
+			args: args{
+				a: "K",
+				b: "k",
+			},
+			want: true,
+		},
+		{
+			name: "different string",
+			args: args{
+				a: "go",
+				b: "goo",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StringFold.Equal(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := StringFold.Compare(tt.args.a, tt.args.b) == 0; got != tt.want {
+				t.Errorf("Compare() == 0 is %v, want %v", got, tt.want)
+			}
+			if tt.want && StringFold.Hash(tt.args.a) != StringFold.Hash(tt.args.b) {
+				t.Errorf("Hash() differs for equal strings")
+			}
+		})
+	}
+}
+
+func TestFloatEpsilon(t *testing.T) {
+	eps := FloatEpsilon(0.01)
+	if !eps.Equal(0.1+0.2, 0.3) || eps.Equal(1, 1.1) {
+		t.Errorf("Equal() returned unexpected result")
+	}
+	if eps.Compare(1, 1.005) != 0 || eps.Compare(1, 2) >= 0 || eps.Compare(2, 1) <= 0 {
+		t.Errorf("Compare() returned unexpected result")
+	}
+}
+
+func TestBy(t *testing.T) {
+	by := By(func(value Item) int {
+		return value.ID
+	})
+	if !by.Equal(Item{1, "a"}, Item{1, "b"}) || by.Equal(Item{1, "a"}, Item{2, "a"}) {
+		t.Errorf("Equal() returned unexpected result")
+	}
+	if by.Hash(Item{1, "a"}) != by.Hash(Item{1, "b"}) {
+		t.Errorf("Hash() differs for equal elements")
+	}
+}
+
+func TestList_Contains_Equaler(t *testing.T) {
+	type args struct {
+		value string
+		eq    []Equaler[string]
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "without equaler",
+			args: args{
+				value: "GO",
+			},
+			want: false,
+		},
+		{
+			name: "with equaler",
+			args: args{
+				value: "GO",
+				eq:    []Equaler[string]{StringFold},
+			},
+			want: true,
+		},
+		{
+			name: "with function",
+			args: args{
+				value: "rust",
+				eq: []Equaler[string]{EqualerFunc[string](func(a, b string) bool {
+					return len(a) == len(b)
+				})},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := From([]string{"go", "java"})
+			if got := l.Contains(tt.args.value, tt.args.eq...); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+			if got := l.ToSet().Contains(tt.args.value, tt.args.eq...); got != tt.want {
+				t.Errorf("Set.Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_Contains_EqualMethod(t *testing.T) {
+	l := From([]Version{{1, 1}, {2, 1}})
+	if !l.Contains(Version{2, 5}) {
+		t.Errorf("Contains() did not use Equal method")
+	}
+	if !l.ToSet().Contains(Version{2, 5}) {
+		t.Errorf("Set.Contains() did not use Equal method")
+	}
+}
+
+func TestList_Distinct_Equaler(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{
+			name: "hasher",
+			got:  From([]string{"Go", "go", "Java", "GO", "java"}).Distinct(StringFold).ToSlice(),
+			want: []string{"Go", "Java"},
+		},
+		{
+			name: "equaler",
+			got:  From([]float64{1, 1.001, 2, 2.5, 1.999}).Distinct(FloatEpsilon(0.01)).ToSlice(),
+			want: []float64{1, 2, 2.5},
+		},
+		{
+			name: "by key",
+			got: From([]Item{{1, "a"}, {2, "b"}, {1, "c"}}).Distinct(By(func(value Item) int {
+				return value.ID
+			})).ToSlice(),
+			want: []Item{{1, "a"}, {2, "b"}},
+		},
+		{
+			name: "equal method",
+			got:  From([]Version{{1, 1}, {1, 2}, {2, 1}}).Distinct().ToSlice(),
+			want: []Version{{1, 1}, {2, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("Distinct() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_SequenceEqual_Equaler(t *testing.T) {
+	a := From([]string{"Go", "Java"})
+	b := From([]string{"go", "JAVA"})
+	if a.SequenceEqual(b) {
+		t.Errorf("SequenceEqual() = true without equaler")
+	}
+	if !a.SequenceEqual(b, StringFold) {
+		t.Errorf("SequenceEqual() = false with equaler")
+	}
+}
+
+func TestList_Order(t *testing.T) {
+	got := From([]string{"b", "C", "a"}).Order(StringFold).ToSlice()
+	if want := []string{"a", "b", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Order() = %v, want %v", got, want)
+	}
+
+	versions := From([]Version{{3, 1}, {1, 1}, {2, 1}}).Order()
+	if want := []Version{{1, 1}, {2, 1}, {3, 1}}; !reflect.DeepEqual(versions.ToSlice(), want) {
+		t.Errorf("Order() = %v, want %v", versions.ToSlice(), want)
+	}
+	if !versions.Contains(Version{2, 9}) {
+		t.Errorf("SortedList.Contains() did not use Equal method")
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("Order() did not panic without comparer")
+		}
+	}()
+	From([]T{1}).Order()
+}
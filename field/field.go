@@ -0,0 +1,253 @@
+// Package field generates selectors and predicates for linq callbacks from struct field paths.
+//
+// A path is a dot separated list of field names such as "Profile.Country".
+// Each segment matches a `linq:"name"` tag, which replaces the Go field name like
+// encoding/json, or the Go name of an untagged field. Fields tagged `linq:"-"` are hidden.
+// Pointers are dereferenced on the way and fields promoted from embedded structs are visible.
+package field
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// plans caches compiled paths by root type and path.
+var plans sync.Map
+
+type planKey struct {
+	typ  reflect.Type
+	path string
+}
+
+type plan struct {
+	steps [][]int
+	typ   reflect.Type
+}
+
+// Path is a compiled field path of T.
+type Path[T any] struct {
+	path string
+	plan *plan
+}
+
+// Compile validates path against T and returns compiled Path.
+// If path does not resolve to an exported field, then it returns error.
+func Compile[T any](path string) (*Path[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	key := planKey{typ: t, path: path}
+	if p, ok := plans.Load(key); ok {
+		return &Path[T]{path: path, plan: p.(*plan)}, nil
+	}
+
+	p, err := compile(t, path)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := plans.LoadOrStore(key, p)
+
+	return &Path[T]{path: path, plan: actual.(*plan)}, nil
+}
+
+// MustCompile validates path against T and returns compiled Path.
+// If path does not resolve to an exported field, then it raises panic.
+func MustCompile[T any](path string) *Path[T] {
+	p, err := Compile[T](path)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// Type returns type of the field.
+func (p *Path[T]) Type() reflect.Type {
+	return p.plan.typ
+}
+
+// Value returns value of the field in v.
+// If a nil pointer is found on the way, then it returns false.
+func (p *Path[T]) Value(v T) (any, bool) {
+	rv, ok := p.plan.get(reflect.ValueOf(&v).Elem())
+	if !ok {
+		return nil, false
+	}
+
+	return rv.Interface(), true
+}
+
+// Float returns selector that converts numeric field to float64.
+// It can be passed to Max, Min, Average and Sum.
+// If path is invalid or field is not numeric, then it raises panic.
+// A nil pointer on the way is read as 0.
+func Float[T any](path string) func(value T, index int) float64 {
+	p := MustCompile[T](path)
+	kind := deref(p.plan.typ).Kind()
+	if !isNumeric(kind) {
+		panic(fmt.Errorf("field is not numeric: %v (%v)", path, p.plan.typ))
+	}
+
+	return func(value T, index int) float64 {
+		rv, ok := p.plan.get(reflect.ValueOf(&value).Elem())
+		if !ok {
+			return 0
+		}
+		rv, ok = indirect(rv)
+		if !ok {
+			return 0
+		}
+
+		switch {
+		case rv.CanInt():
+			return float64(rv.Int())
+		case rv.CanUint():
+			return float64(rv.Uint())
+		default:
+			return rv.Float()
+		}
+	}
+}
+
+// Eq returns predicate that reports whether field equals to want.
+// It can be passed to Where, First, Any and so on.
+// If path is invalid or want can not be compared with field, then it raises panic.
+// A nil pointer on the way never matches.
+func Eq[T any](path string, want any) func(value T, index int) bool {
+	p := MustCompile[T](path)
+	w := convert(p, want)
+
+	return func(value T, index int) bool {
+		rv, ok := p.plan.get(reflect.ValueOf(&value).Elem())
+		if !ok {
+			return false
+		}
+		rv, ok = indirect(rv)
+		if !ok {
+			return false
+		}
+
+		return rv.Interface() == w
+	}
+}
+
+// Ne returns predicate that reports whether field does not equal to want.
+// If path is invalid or want can not be compared with field, then it raises panic.
+// A nil pointer on the way always matches.
+func Ne[T any](path string, want any) func(value T, index int) bool {
+	eq := Eq[T](path, want)
+
+	return func(value T, index int) bool {
+		return !eq(value, index)
+	}
+}
+
+func compile(t reflect.Type, path string) (*plan, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path is empty")
+	}
+
+	p := &plan{}
+	cur := t
+	for _, name := range strings.Split(path, ".") {
+		cur = deref(cur)
+		if cur.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("not a struct: %v in %v", cur, path)
+		}
+
+		f, ok := lookup(cur, name)
+		if !ok {
+			return nil, fmt.Errorf("field not found: %v in %v", name, path)
+		}
+		p.steps = append(p.steps, f.Index)
+		cur = f.Type
+	}
+	p.typ = cur
+
+	return p, nil
+}
+
+// lookup returns exported field named name.
+// Tag replaces Go name of the field, and fields tagged "-" are hidden.
+// Tagged fields are preferred to fields named by Go name.
+func lookup(t reflect.Type, name string) (reflect.StructField, bool) {
+	fields := reflect.VisibleFields(t)
+	for _, f := range fields {
+		if tag := tagName(f); f.IsExported() && tag != "-" && tag == name {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if f.IsExported() && tagName(f) == "" && f.Name == name {
+			return f, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func tagName(f reflect.StructField) string {
+	tag, _, _ := strings.Cut(f.Tag.Get("linq"), ",")
+	return tag
+}
+
+func (p *plan) get(v reflect.Value) (reflect.Value, bool) {
+	for _, index := range p.steps {
+		s, ok := indirect(v)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		field, err := s.FieldByIndexErr(index)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		v = field
+	}
+
+	return v, true
+}
+
+// convert converts want to the field type so that it can be compared with ==.
+func convert[T any](p *Path[T], want any) any {
+	t := deref(p.plan.typ)
+	if !t.Comparable() {
+		panic(fmt.Errorf("field is not comparable: %v (%v)", p.path, p.plan.typ))
+	}
+
+	w := reflect.ValueOf(want)
+	if !w.IsValid() || !w.Type().ConvertibleTo(t) || (t.Kind() == reflect.String) != (w.Kind() == reflect.String) {
+		panic(fmt.Errorf("can not compare field %v (%v) with %T", p.path, p.plan.typ, want))
+	}
+
+	return w.Convert(t).Interface()
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+func isNumeric(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
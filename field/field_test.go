@@ -0,0 +1,378 @@
+package field
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/YusukeKishino/go-linq"
+)
+
+type Profile struct {
+	Country string `linq:"country"`
+	Score   *float64
+}
+
+type Base struct {
+	ID int
+}
+
+type User struct {
+	Base
+	Name     string
+	Age      int
+	Profile  *Profile
+	Password string `linq:"-"`
+	secret   int
+}
+
+func score(v float64) *float64 {
+	return &v
+}
+
+func users() []User {
+	return []User{
+		{Base: Base{ID: 1}, Name: "alice", Age: 30, Profile: &Profile{Country: "JP", Score: score(1.5)}},
+		{Base: Base{ID: 2}, Name: "bob", Age: 20, Profile: &Profile{Country: "US"}},
+		{Base: Base{ID: 3}, Name: "carol", Age: 40},
+	}
+}
+
+func TestCompile(t *testing.T) {
+	type args struct {
+		path string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    reflect.Type
+		wantErr bool
+	}{
+		{
+			name: "field",
+			args: args{
+				path: "Age",
+			},
+			want:    reflect.TypeOf(0),
+			wantErr: false,
+		},
+		{
+			name: "nested field through pointer",
+			args: args{
+				path: "Profile.Score",
+			},
+			want:    reflect.TypeOf((*float64)(nil)),
+			wantErr: false,
+		},
+		{
+			name: "tag name",
+			args: args{
+				path: "Profile.country",
+			},
+			want:    reflect.TypeOf(""),
+			wantErr: false,
+		},
+		{
+			name: "embedded field",
+			args: args{
+				path: "ID",
+			},
+			want:    reflect.TypeOf(0),
+			wantErr: false,
+		},
+		{
+			name: "empty path",
+			args: args{
+				path: "",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			args: args{
+				path: "Profile.City",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "go name of tagged field",
+			args: args{
+				path: "Profile.Country",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "hidden field",
+			args: args{
+				path: "Password",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "hidden tag",
+			args: args{
+				path: "-",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "unexported field",
+			args: args{
+				path: "secret",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "not a struct",
+			args: args{
+				path: "Age.Value",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compile[User](tt.args.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Type() != tt.want {
+				t.Errorf("Compile() type = %v, want %v", got.Type(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCompile_Cache(t *testing.T) {
+	a := MustCompile[User]("Profile.country")
+	b := MustCompile[User]("Profile.country")
+	if a.plan != b.plan {
+		t.Errorf("Compile() did not reuse cached plan")
+	}
+}
+
+func TestPath_Value(t *testing.T) {
+	type args struct {
+		path  string
+		value User
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   any
+		wantOK bool
+	}{
+		{
+			name: "nested value",
+			args: args{
+				path:  "Profile.country",
+				value: users()[0],
+			},
+			want:   "JP",
+			wantOK: true,
+		},
+		{
+			name: "embedded value",
+			args: args{
+				path:  "ID",
+				value: users()[1],
+			},
+			want:   2,
+			wantOK: true,
+		},
+		{
+			name: "nil pointer on the way",
+			args: args{
+				path:  "Profile.country",
+				value: users()[2],
+			},
+			want:   nil,
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := MustCompile[User](tt.args.path).Value(tt.args.value)
+			if ok != tt.wantOK {
+				t.Errorf("Value() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat(t *testing.T) {
+	type args struct {
+		path string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   []float64
+		raised bool
+	}{
+		{
+			name: "int field",
+			args: args{
+				path: "Age",
+			},
+			want:   []float64{30, 20, 40},
+			raised: false,
+		},
+		{
+			name: "pointer field and nil pointers",
+			args: args{
+				path: "Profile.Score",
+			},
+			want:   []float64{1.5, 0, 0},
+			raised: false,
+		},
+		{
+			name: "not numeric",
+			args: args{
+				path: "Name",
+			},
+			want:   nil,
+			raised: true,
+		},
+		{
+			name: "unknown field",
+			args: args{
+				path: "Height",
+			},
+			want:   nil,
+			raised: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err := recover()
+				if (err != nil) != tt.raised {
+					t.Errorf("Float() panic = %v, raised %v", err, tt.raised)
+				}
+			}()
+			f := Float[User](tt.args.path)
+			var got []float64
+			for i, u := range users() {
+				got = append(got, f(u, i))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Float() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEq(t *testing.T) {
+	type args struct {
+		path string
+		want any
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   []bool
+		raised bool
+	}{
+		{
+			name: "nested string field",
+			args: args{
+				path: "Profile.country",
+				want: "JP",
+			},
+			want:   []bool{true, false, false},
+			raised: false,
+		},
+		{
+			name: "convertible number",
+			args: args{
+				path: "Age",
+				want: int64(20),
+			},
+			want:   []bool{false, true, false},
+			raised: false,
+		},
+		{
+			name: "pointer field",
+			args: args{
+				path: "Profile.Score",
+				want: 1.5,
+			},
+			want:   []bool{true, false, false},
+			raised: false,
+		},
+		{
+			name: "number compared with string",
+			args: args{
+				path: "Name",
+				want: 65,
+			},
+			want:   nil,
+			raised: true,
+		},
+		{
+			name: "nil",
+			args: args{
+				path: "Age",
+				want: nil,
+			},
+			want:   nil,
+			raised: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err := recover()
+				if (err != nil) != tt.raised {
+					t.Errorf("Eq() panic = %v, raised %v", err, tt.raised)
+				}
+			}()
+			f := Eq[User](tt.args.path, tt.args.want)
+			var got []bool
+			for i, u := range users() {
+				got = append(got, f(u, i))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Eq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNe(t *testing.T) {
+	f := Ne[User]("Profile.country", "JP")
+	got := []bool{}
+	for i, u := range users() {
+		got = append(got, f(u, i))
+	}
+	if want := []bool{false, true, true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Ne() = %v, want %v", got, want)
+	}
+}
+
+func TestWithList(t *testing.T) {
+	l := linq.From(users())
+	if got := l.Max(Float[User]("Age")).Name; got != "carol" {
+		t.Errorf("Max() = %v, want %v", got, "carol")
+	}
+	if got := l.Sum(Float[User]("ID")); got != 6 {
+		t.Errorf("Sum() = %v, want %v", got, 6)
+	}
+	if got := l.Count(Eq[User]("Profile.country", "US")); got != 1 {
+		t.Errorf("Count() = %v, want %v", got, 1)
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/YusukeKishino/go-linq
 
-go 1.18
+go 1.24
@@ -0,0 +1,268 @@
+package linq
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Index is hash index of List by key for repeated lookups.
+// It is a snapshot of the list it was built from, see Covers and Refresh.
+type Index[T comparable, K comparable] struct {
+	source *List[T]
+	key    func(value T, index int) K
+	m      map[K][]T
+}
+
+// IndexBy builds hash index of elements by key
+func IndexBy[T comparable, K comparable](l *List[T], key func(value T, index int) K) *Index[T, K] {
+	m := make(map[K][]T)
+	for i, t := range l.items() {
+		k := key(t, i)
+		m[k] = append(m[k], t)
+	}
+
+	return &Index[T, K]{source: l, key: key, m: m}
+}
+
+// lookup returns elements which have key. Nil index is empty.
+func (ix *Index[T, K]) lookup(key K) ([]T, bool) {
+	if ix == nil {
+		return nil, false
+	}
+	s, ok := ix.m[key]
+	return s, ok
+}
+
+// Covers returns true if index was built from l
+func (ix *Index[T, K]) Covers(l *List[T]) bool {
+	return ix != nil && ix.source == l
+}
+
+// Refresh returns index of l by the same key.
+// If index was built from l or index is nil, then it returns index itself.
+func (ix *Index[T, K]) Refresh(l *List[T]) *Index[T, K] {
+	if ix == nil || ix.Covers(l) {
+		return ix
+	}
+	return IndexBy(l, ix.key)
+}
+
+// Where returns elements which have key
+func (ix *Index[T, K]) Where(key K) *List[T] {
+	s, ok := ix.lookup(key)
+	if !ok {
+		return From([]T{})
+	}
+	return From(s[:len(s):len(s)])
+}
+
+// First gets first element which has key.
+// If element is not found, then it returns error.
+func (ix *Index[T, K]) First(key K) (T, error) {
+	s, ok := ix.lookup(key)
+	if !ok {
+		return *new(T), ErrNotFound
+	}
+	return s[0], nil
+}
+
+// ContainsKey returns true if there is element which has key
+func (ix *Index[T, K]) ContainsKey(key K) bool {
+	_, ok := ix.lookup(key)
+	return ok
+}
+
+// IndexedList is List whose lookups by key use Index instead of scanning elements.
+// Where and First look up elements by index when they are given a predicate made by Key,
+// and scan elements like List otherwise.
+type IndexedList[T comparable, K comparable] struct {
+	*List[T]
+	ix *Index[T, K]
+	// keyCode is code pointer of predicates made by Key.
+	keyCode uintptr
+	// probes is keys asked from predicates made by Key, by negative index passed to them.
+	probes    sync.Map
+	lastProbe atomic.Int64
+}
+
+// WithIndex returns l which looks up elements by key of ix.
+// If ix was not built from l, then it is rebuilt from l, so lookups never see stale elements.
+// If ix is nil, then lookups find no element.
+func WithIndex[T comparable, K comparable](l *List[T], ix *Index[T, K]) *IndexedList[T, K] {
+	indexed := &IndexedList[T, K]{List: l, ix: ix.Refresh(l)}
+	indexed.keyCode = reflect.ValueOf(indexed.Key(*new(K))).Pointer()
+	return indexed
+}
+
+// Key returns predicate which matches elements whose key equals to key.
+// It can be passed to any operator, and Where and First of l use index for it.
+func (l *IndexedList[T, K]) Key(key K) func(value T, index int) bool {
+	return func(value T, index int) bool {
+		if index < 0 {
+			p, ok := l.probes.Load(index)
+			if ok {
+				*p.(*K) = key
+			}
+			return ok
+		}
+		return l.ix != nil && l.ix.key(value, index) == key
+	}
+}
+
+// keyOf returns key of f if f is made by Key of l.
+func (l *IndexedList[T, K]) keyOf(f func(value T, index int) bool) (K, bool) {
+	var key K
+	if f == nil || reflect.ValueOf(f).Pointer() != l.keyCode {
+		return key, false
+	}
+
+	// Elements never have negative index, so f reports its key instead of matching.
+	probe := -int(l.lastProbe.Add(1))
+	l.probes.Store(probe, &key)
+	defer l.probes.Delete(probe)
+	ok := f(*new(T), probe)
+
+	return key, ok
+}
+
+// Where returns condition matched elements.
+// If f is made by Key, then elements are looked up by index.
+func (l *IndexedList[T, K]) Where(f func(value T, index int) bool) *List[T] {
+	if key, ok := l.keyOf(f); ok {
+		return l.WhereKey(key)
+	}
+	return l.List.Where(f)
+}
+
+// First gets first element of List.
+// If filter is made by Key, then element is looked up by index.
+// If element is not found, then it returns error.
+func (l *IndexedList[T, K]) First(filter ...func(value T, index int) bool) (T, error) {
+	if len(filter) > 0 {
+		if key, ok := l.keyOf(filter[0]); ok {
+			if len(l.items()) == 0 {
+				return *new(T), ErrEmpty
+			}
+			return l.FirstKey(key)
+		}
+	}
+	return l.List.First(filter...)
+}
+
+// WhereKey returns elements which have key, in order of list
+func (l *IndexedList[T, K]) WhereKey(key K) *List[T] {
+	sp := l.span("WhereKey")
+	s, ok := l.ix.lookup(key)
+	if !ok {
+		return sp.end([]T{})
+	}
+	return sp.end(s[:len(s):len(s)])
+}
+
+// FirstKey gets first element which has key.
+// If element is not found, then it returns error.
+func (l *IndexedList[T, K]) FirstKey(key K) (T, error) {
+	return l.ix.First(key)
+}
+
+// Join returns results of pairs of outer and inner elements which have the same key.
+// It builds hash index of inner.
+func Join[O comparable, I comparable, K comparable, R comparable](outer *List[O], inner *List[I], outerKey func(value O, index int) K, innerKey func(value I, index int) K, result func(o O, i I) R) *List[R] {
+	return JoinIndex(outer, IndexBy(inner, innerKey), outerKey, result)
+}
+
+// JoinIndex returns results of pairs of outer elements and elements of index which have the same key
+func JoinIndex[O comparable, I comparable, K comparable, R comparable](outer *List[O], inner *Index[I, K], outerKey func(value O, index int) K, result func(o O, i I) R) *List[R] {
+	s := make([]R, 0, len(outer.items()))
+	for i, o := range outer.items() {
+		matched, _ := inner.lookup(outerKey(o, i))
+		for _, in := range matched {
+			s = append(s, result(o, in))
+		}
+	}
+
+	return From(s)
+}
+
+// OrderedIndex is sorted index of List by key for repeated range lookups.
+// It is a snapshot of the list it was built from, see Covers and Refresh.
+type OrderedIndex[T comparable, K comparable] struct {
+	source  *List[T]
+	key     func(value T, index int) K
+	cmp     func(a, b K) int
+	entries *SortedList[KeyValue[K, T]]
+}
+
+// OrderedIndexBy builds sorted index of elements by key and cmp
+func OrderedIndexBy[T comparable, K comparable](l *List[T], key func(value T, index int) K, cmp func(a, b K) int) *OrderedIndex[T, K] {
+	s := make([]KeyValue[K, T], len(l.items()))
+	for i, t := range l.items() {
+		s[i] = KeyValue[K, T]{Key: key(t, i), Value: t}
+	}
+
+	return &OrderedIndex[T, K]{
+		source: l,
+		key:    key,
+		cmp:    cmp,
+		entries: From(s).OrderBy(func(a, b KeyValue[K, T]) int {
+			return cmp(a.Key, b.Key)
+		}),
+	}
+}
+
+// sorted returns entries of index. Nil index is empty.
+func (ix *OrderedIndex[T, K]) sorted() *SortedList[KeyValue[K, T]] {
+	if ix == nil {
+		return nil
+	}
+	return ix.entries
+}
+
+// Covers returns true if index was built from l
+func (ix *OrderedIndex[T, K]) Covers(l *List[T]) bool {
+	return ix != nil && ix.source == l
+}
+
+// Refresh returns index of l by the same key and cmp.
+// If index was built from l or index is nil, then it returns index itself.
+func (ix *OrderedIndex[T, K]) Refresh(l *List[T]) *OrderedIndex[T, K] {
+	if ix == nil || ix.Covers(l) {
+		return ix
+	}
+	return OrderedIndexBy(l, ix.key, ix.cmp)
+}
+
+// Where returns elements which have key
+func (ix *OrderedIndex[T, K]) Where(key K) *List[T] {
+	entries := ix.sorted()
+	i := entries.LowerBound(KeyValue[K, T]{Key: key})
+	j := entries.UpperBound(KeyValue[K, T]{Key: key})
+
+	return values(entries.items()[i:j])
+}
+
+// First gets first element which has key.
+// If element is not found, then it returns error.
+func (ix *OrderedIndex[T, K]) First(key K) (T, error) {
+	entries := ix.sorted()
+	i, ok := entries.BinarySearch(KeyValue[K, T]{Key: key})
+	if !ok {
+		return *new(T), ErrNotFound
+	}
+	return entries.items()[i].Value, nil
+}
+
+// Range returns elements whose key is not less than lo and less than hi, ordered by key
+func (ix *OrderedIndex[T, K]) Range(lo, hi K) *List[T] {
+	return values(ix.sorted().Range(KeyValue[K, T]{Key: lo}, KeyValue[K, T]{Key: hi}).items())
+}
+
+func values[K comparable, V comparable](pairs []KeyValue[K, V]) *List[V] {
+	s := make([]V, len(pairs))
+	for i, p := range pairs {
+		s[i] = p.Value
+	}
+
+	return From(s)
+}
@@ -0,0 +1,214 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+type Item struct {
+	ID    int
+	Group string
+}
+
+func itemID(value Item, index int) int {
+	return value.ID
+}
+
+func itemGroup(value Item, index int) string {
+	return value.Group
+}
+
+func items() *List[Item] {
+	return From([]Item{{3, "b"}, {1, "a"}, {2, "b"}, {5, "c"}, {4, "a"}})
+}
+
+func TestIndexBy(t *testing.T) {
+	ix := IndexBy(items(), itemGroup)
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      []Item
+		wantFirst Item
+		wantErr   bool
+	}{
+		{
+			name: "elements which have key",
+			args: args{
+				key: "b",
+			},
+			want:      []Item{{3, "b"}, {2, "b"}},
+			wantFirst: Item{3, "b"},
+			wantErr:   false,
+		},
+		{
+			name: "missing key",
+			args: args{
+				key: "x",
+			},
+			want:      []Item{},
+			wantFirst: Item{},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ix.Where(tt.args.key).ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Where() = %v, want %v", got, tt.want)
+			}
+			got, err := ix.First(tt.args.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("First() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.wantFirst {
+				t.Errorf("First() = %v, want %v", got, tt.wantFirst)
+			}
+			if ok := ix.ContainsKey(tt.args.key); ok == tt.wantErr {
+				t.Errorf("ContainsKey() = %v, want %v", ok, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIndex_Refresh(t *testing.T) {
+	l := items()
+	ix := IndexBy(l, itemID)
+	if !ix.Covers(l) || ix.Refresh(l) != ix {
+		t.Errorf("Refresh() rebuilt index of the same list")
+	}
+
+	b := l.ToBuilder()
+	b.Add(Item{6, "c"})
+	rebuilt := b.Build()
+	if ix.Covers(rebuilt) {
+		t.Errorf("Covers() = true for rebuilt list")
+	}
+	if ix.ContainsKey(6) {
+		t.Errorf("index changed by rebuilding list")
+	}
+	if got, err := ix.Refresh(rebuilt).First(6); err != nil || got != (Item{6, "c"}) {
+		t.Errorf("Refresh().First() = %v, %v", got, err)
+	}
+}
+
+func TestWithIndex(t *testing.T) {
+	l := items()
+	ix := IndexBy(l, itemGroup)
+	indexed := WithIndex(l, ix)
+	if indexed.ix != ix {
+		t.Errorf("WithIndex() rebuilt index of the same list")
+	}
+	if got, want := indexed.WhereKey("b"), l.Where(func(v Item, i int) bool { return v.Group == "b" }); !reflect.DeepEqual(got, want) {
+		t.Errorf("WhereKey() = %v, want %v", got, want)
+	}
+	if got := indexed.WhereKey("x"); !reflect.DeepEqual(got, &List[Item]{slice: []Item{}}) {
+		t.Errorf("WhereKey() = %v, want empty list", got)
+	}
+	if got, err := indexed.FirstKey("a"); err != nil || got != (Item{1, "a"}) {
+		t.Errorf("FirstKey() = %v, %v", got, err)
+	}
+	if _, err := indexed.FirstKey("x"); err != ErrNotFound {
+		t.Errorf("FirstKey() error = %v, want %v", err, ErrNotFound)
+	}
+	if got := indexed.Count(); got != 5 {
+		t.Errorf("Count() = %v, want %v", got, 5)
+	}
+
+	b := l.ToBuilder()
+	b.Add(Item{6, "c"})
+	if got := WithIndex(b.Build(), ix).WhereKey("c").Count(); got != 2 {
+		t.Errorf("WhereKey() on other list = %v elements, want %v", got, 2)
+	}
+}
+
+func TestIndexedList_Key(t *testing.T) {
+	l := items()
+	calls := 0
+	indexed := WithIndex(l, IndexBy(l, func(value Item, index int) string {
+		calls++
+		return value.Group
+	}))
+	byGroup := func(group string) func(value Item, index int) bool {
+		return func(value Item, index int) bool { return value.Group == group }
+	}
+
+	calls = 0
+	if got, want := indexed.Where(indexed.Key("b")), l.Where(byGroup("b")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Where() = %v, want %v", got, want)
+	}
+	if got, err := indexed.First(indexed.Key("a")); err != nil || got != (Item{1, "a"}) {
+		t.Errorf("First() = %v, %v", got, err)
+	}
+	if _, err := indexed.First(indexed.Key("x")); err != ErrNotFound {
+		t.Errorf("First() error = %v, want %v", err, ErrNotFound)
+	}
+	if calls != 0 {
+		t.Errorf("Where() and First() called key %v times, want index lookup", calls)
+	}
+
+	if got, want := indexed.Where(byGroup("b")), l.Where(byGroup("b")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Where() = %v, want %v", got, want)
+	}
+	if got, want := l.Where(indexed.Key("b")), l.Where(byGroup("b")); !reflect.DeepEqual(got, want) {
+		t.Errorf("List.Where() = %v, want %v", got, want)
+	}
+	if calls != l.Count() {
+		t.Errorf("List.Where() called key %v times, want %v", calls, l.Count())
+	}
+
+	other := WithIndex(items(), IndexBy(items(), itemGroup))
+	if got, want := indexed.Where(other.Key("c")), l.Where(byGroup("c")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Where() with key of other list = %v, want %v", got, want)
+	}
+	empty := WithIndex(From([]Item{}), IndexBy(From([]Item{}), itemGroup))
+	if _, err := empty.First(empty.Key("a")); err != ErrEmpty {
+		t.Errorf("First() error = %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	type Group struct {
+		Name  string
+		Label string
+	}
+	groups := From([]Group{{"a", "Alpha"}, {"b", "Beta"}, {"z", "Zeta"}})
+	got := Join(groups, items(), func(value Group, index int) string {
+		return value.Name
+	}, itemGroup, func(o Group, i Item) string {
+		return o.Label + ":" + string(rune('0'+i.ID))
+	})
+
+	want := []string{"Alpha:1", "Alpha:4", "Beta:3", "Beta:2"}
+	if !reflect.DeepEqual(got.ToSlice(), want) {
+		t.Errorf("Join() = %v, want %v", got.ToSlice(), want)
+	}
+}
+
+func TestOrderedIndexBy(t *testing.T) {
+	ix := OrderedIndexBy(items(), itemID, func(a, b int) int {
+		return a - b
+	})
+
+	if got := ix.Range(2, 5).ToSlice(); !reflect.DeepEqual(got, []Item{{2, "b"}, {3, "b"}, {4, "a"}}) {
+		t.Errorf("Range() = %v", got)
+	}
+	if got := ix.Where(5).ToSlice(); !reflect.DeepEqual(got, []Item{{5, "c"}}) {
+		t.Errorf("Where() = %v", got)
+	}
+	if got, err := ix.First(1); err != nil || got != (Item{1, "a"}) {
+		t.Errorf("First() = %v, %v", got, err)
+	}
+	if _, err := ix.First(9); err == nil {
+		t.Errorf("First() error = nil, want error")
+	}
+
+	l := items()
+	if ix.Covers(l) {
+		t.Errorf("Covers() = true for another list")
+	}
+	if got := ix.Refresh(l); got == ix || !got.Covers(l) {
+		t.Errorf("Refresh() did not rebuild index")
+	}
+}
@@ -1,11 +1,40 @@
 package linq
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
+	"sync"
 )
 
+// List is read-only list of elements.
+// A nil *List and zero value of List are valid empty lists.
 type List[T comparable] struct {
-	slice []T
+	slice  []T
+	logger *slog.Logger
+}
+
+var (
+	// ErrEmpty is returned when element is requested from empty list.
+	ErrEmpty = errors.New("length is 0")
+	// ErrNotFound is returned when no element matches condition.
+	ErrNotFound = errors.New("not found")
+	// ErrMultiple is returned when more than one element matches condition.
+	ErrMultiple = errors.New("more than one element")
+)
+
+// emptyLists holds Empty list of each element type.
+var emptyLists sync.Map
+
+// Queryable is query surface shared by List, Set, Dictionary and Lookup.
+type Queryable[T comparable] interface {
+	First(filter ...func(value T, index int) bool) (T, error)
+	Where(f func(value T, index int) bool) *List[T]
+	All(f func(value T, index int) bool) bool
+	Any(f ...func(value T, index int) bool) bool
+	Contains(value T, eq ...Equaler[T]) bool
+	Count(f ...func(value T, index int) bool) int
+	ToList() *List[T]
 }
 
 // From is constructor of List.
@@ -15,24 +44,43 @@ func From[T comparable](s []T) *List[T] {
 	}
 }
 
+// Empty returns shared empty list of T.
+func Empty[T comparable]() *List[T] {
+	var key any = (*List[T])(nil)
+	if l, ok := emptyLists.Load(key); ok {
+		return l.(*List[T])
+	}
+	l, _ := emptyLists.LoadOrStore(key, From([]T{}))
+	return l.(*List[T])
+}
+
+// items returns elements of List.
+// It returns nil for nil List.
+func (l *List[T]) items() []T {
+	if l == nil {
+		return nil
+	}
+	return l.slice
+}
+
 // First gets first element of List.
 // If element is not found, then it returns error.
 func (l *List[T]) First(filter ...func(value T, index int) bool) (T, error) {
-	if len(l.slice) == 0 {
-		return *new(T), fmt.Errorf("length is 0")
+	if len(l.items()) == 0 {
+		return *new(T), ErrEmpty
 	}
 
 	if len(filter) > 0 {
-		for i, t := range l.slice {
+		for i, t := range l.items() {
 			if filter[0](t, i) {
 				return t, nil
 			}
 		}
 
-		return *new(T), fmt.Errorf("not found")
+		return *new(T), ErrNotFound
 	}
 
-	return l.slice[0], nil
+	return l.items()[0], nil
 }
 
 // MustFirst gets first element of List.
@@ -57,24 +105,35 @@ func (l *List[T]) FirstOrDefault(filter ...func(value T, index int) bool) T {
 	return first
 }
 
+// FirstOr gets first element of List.
+// If element is not found, then it returns defaultT.
+func (l *List[T]) FirstOr(defaultT T, filter ...func(value T, index int) bool) T {
+	first, err := l.First(filter...)
+	if err != nil {
+		return defaultT
+	}
+
+	return first
+}
+
 // Last gets last element of List.
 // If element is not found, then it returns error.
 func (l *List[T]) Last(filter ...func(value T, index int) bool) (T, error) {
-	if len(l.slice) == 0 {
-		return *new(T), fmt.Errorf("length is 0")
+	if len(l.items()) == 0 {
+		return *new(T), ErrEmpty
 	}
 
 	if len(filter) > 0 {
-		for i := len(l.slice) - 1; i >= 0; i-- {
-			if filter[0](l.slice[i], i) {
-				return l.slice[i], nil
+		for i := len(l.items()) - 1; i >= 0; i-- {
+			if filter[0](l.items()[i], i) {
+				return l.items()[i], nil
 			}
 		}
 
-		return *new(T), fmt.Errorf("not found")
+		return *new(T), ErrNotFound
 	}
 
-	return l.slice[len(l.slice)-1], nil
+	return l.items()[len(l.items())-1], nil
 }
 
 // MustLast gets last element of List.
@@ -99,13 +158,60 @@ func (l *List[T]) LastOrDefault(filter ...func(value T, index int) bool) T {
 	return last
 }
 
+// LastOr gets last element of List.
+// If element is not found, then it returns defaultT.
+func (l *List[T]) LastOr(defaultT T, filter ...func(value T, index int) bool) T {
+	last, err := l.Last(filter...)
+	if err != nil {
+		return defaultT
+	}
+
+	return last
+}
+
+// Single gets the only element of List.
+// If element is not found or there are more than one elements, then it returns error.
+func (l *List[T]) Single(filter ...func(value T, index int) bool) (T, error) {
+	if len(l.items()) == 0 {
+		return *new(T), ErrEmpty
+	}
+
+	var single T
+	found := false
+	for i, t := range l.items() {
+		if len(filter) > 0 && !filter[0](t, i) {
+			continue
+		}
+		if found {
+			return *new(T), ErrMultiple
+		}
+		single, found = t, true
+	}
+	if !found {
+		return *new(T), ErrNotFound
+	}
+
+	return single, nil
+}
+
+// MustSingle gets the only element of List.
+// If element is not found or there are more than one elements, then it raises panic.
+func (l *List[T]) MustSingle(filter ...func(value T, index int) bool) T {
+	single, err := l.Single(filter...)
+	if err != nil {
+		panic(err)
+	}
+
+	return single
+}
+
 // At returns specific element by index.
 // If element is not found, then it returns error.
 func (l *List[T]) At(index int) (T, error) {
-	if index < 0 || len(l.slice) <= index {
+	if index < 0 || len(l.items()) <= index {
 		return *new(T), fmt.Errorf("out of index: %v", index)
 	}
-	return l.slice[index], nil
+	return l.items()[index], nil
 }
 
 // MustAt returns specific element by index.
@@ -132,74 +238,80 @@ func (l *List[T]) AtOrDefault(index int) T {
 
 // Skip returns elements after the specified index.
 func (l *List[T]) Skip(index int) *List[T] {
+	sp := l.span("Skip")
 	if index < 0 {
 		index = 0
 	}
-	if index >= len(l.slice) {
-		index = len(l.slice)
+	if index >= len(l.items()) {
+		index = len(l.items())
 	}
-	return From(l.slice[index:])
+	return sp.end(l.items()[index:])
 }
 
 // SkipWhile returns elements after the specified condition.
 func (l *List[T]) SkipWhile(f func(value T, index int) bool) *List[T] {
-	for i, t := range l.slice {
+	sp := l.span("SkipWhile")
+	for i, t := range l.items() {
 		if f(t, i) {
-			return From(l.slice[i:])
+			return sp.end(l.items()[i:])
 		}
 	}
-	return From(l.slice[len(l.slice):])
+	return sp.end(l.items()[len(l.items()):])
 }
 
 // Take returns elements up to the specified index.
 func (l *List[T]) Take(count int) *List[T] {
+	sp := l.span("Take")
 	if count < 0 {
 		count = 0
 	}
-	if count >= len(l.slice) {
-		count = len(l.slice)
+	if count >= len(l.items()) {
+		count = len(l.items())
 	}
-	return From(l.slice[:count])
+	return sp.end(l.items()[:count])
 }
 
 // TakeWhile returns elements up to the specified condition.
 func (l *List[T]) TakeWhile(f func(value T, index int) bool) *List[T] {
-	for i, t := range l.slice {
+	sp := l.span("TakeWhile")
+	for i, t := range l.items() {
 		if !f(t, i) {
-			return From(l.slice[:i])
+			return sp.end(l.items()[:i])
 		}
 	}
-	return l
+	return sp.end(l.items())
 }
 
 // DefaultIfEmpty returns default value if list is empty.
 func (l *List[T]) DefaultIfEmpty(defaultT ...T) *List[T] {
-	if len(l.slice) > 0 {
-		return l
+	sp := l.span("DefaultIfEmpty")
+	if len(l.items()) > 0 {
+		return sp.end(l.items())
 	}
 
 	if len(defaultT) > 0 {
-		return From([]T{defaultT[0]})
+		return sp.end([]T{defaultT[0]})
 	}
 
-	return From([]T{*new(T)})
+	return sp.end([]T{*new(T)})
 }
 
 // Where returns condition matched elements
 func (l *List[T]) Where(f func(value T, index int) bool) *List[T] {
-	s := make([]T, 0, len(l.slice))
-	for i, t := range l.slice {
+	sp := l.span("Where")
+	s := make([]T, 0, len(l.items()))
+	for i, t := range l.items() {
 		if f(t, i) {
 			s = append(s, t)
 		}
 	}
 
-	return From(s)
+	return sp.end(s)
 }
 
 // All returns true if all elements are matched
 func (l *List[T]) All(f func(value T, index int) bool) bool {
-	for i, t := range l.slice {
+	for i, t := range l.items() {
 		if !f(t, i) {
 			return false
 		}
@@ -211,10 +323,10 @@ func (l *List[T]) All(f func(value T, index int) bool) bool {
 // Any returns true if there is matched element
 func (l *List[T]) Any(f ...func(value T, index int) bool) bool {
 	if len(f) == 0 {
-		return len(l.slice) > 0
+		return len(l.items()) > 0
 	}
 
-	for i, t := range l.slice {
+	for i, t := range l.items() {
 		if f[0](t, i) {
 			return true
 		}
@@ -223,10 +335,12 @@ func (l *List[T]) Any(f ...func(value T, index int) bool) bool {
 	return false
 }
 
-// Contains returns true if there is matched element
-func (l *List[T]) Contains(value T) bool {
-	for _, t := range l.slice {
-		if t == value {
+// Contains returns true if there is matched element.
+// Elements are compared by eq, Equal method of T or == in this order.
+func (l *List[T]) Contains(value T, eq ...Equaler[T]) bool {
+	e := equalerOf(eq)
+	for _, t := range l.items() {
+		if e == nil && t == value || e != nil && e.Equal(t, value) {
 			return true
 		}
 	}
@@ -234,13 +348,15 @@ func (l *List[T]) Contains(value T) bool {
 	return false
 }
 
-// SequenceEqual return true if all element of two list are the same values
-func (l *List[T]) SequenceEqual(other *List[T]) bool {
-	if len(l.slice) != len(other.slice) {
+// SequenceEqual return true if all element of two list are the same values.
+// Elements are compared by eq, Equal method of T or == in this order.
+func (l *List[T]) SequenceEqual(other *List[T], eq ...Equaler[T]) bool {
+	if len(l.items()) != len(other.items()) {
 		return false
 	}
-	for i, t := range l.slice {
-		if other.slice[i] != t {
+	e := equalerOf(eq)
+	for i, t := range l.items() {
+		if e == nil && other.items()[i] != t || e != nil && !e.Equal(t, other.items()[i]) {
 			return false
 		}
 	}
@@ -251,21 +367,28 @@ func (l *List[T]) SequenceEqual(other *List[T]) bool {
 // Count returns number of element
 func (l *List[T]) Count(f ...func(value T, index int) bool) int {
 	if len(f) == 0 {
-		return len(l.slice)
+		return len(l.items())
 	}
 
-	return len(l.Where(f[0]).slice)
+	n := 0
+	for i, t := range l.items() {
+		if f[0](t, i) {
+			n++
+		}
+	}
+
+	return n
 }
 
 // Max returns maximum element of list
 func (l *List[T]) Max(f func(value T, index int) float64) T {
-	if len(l.slice) == 0 {
+	if len(l.items()) == 0 {
 		return *new(T)
 	}
 
-	maxV := f(l.slice[0], 0)
-	max := l.slice[0]
-	for i, t := range l.slice[1:] {
+	maxV := f(l.items()[0], 0)
+	max := l.items()[0]
+	for i, t := range l.items()[1:] {
 		v := f(t, i)
 		if maxV < v {
 			maxV = v
@@ -278,13 +401,13 @@ func (l *List[T]) Max(f func(value T, index int) float64) T {
 
 // Min returns minimum element of list
 func (l *List[T]) Min(f func(value T, index int) float64) T {
-	if len(l.slice) == 0 {
+	if len(l.items()) == 0 {
 		return *new(T)
 	}
 
-	minV := f(l.slice[0], 0)
-	min := l.slice[0]
-	for i, t := range l.slice[1:] {
+	minV := f(l.items()[0], 0)
+	min := l.items()[0]
+	for i, t := range l.items()[1:] {
 		v := f(t, i)
 		if minV > v {
 			minV = v
@@ -297,22 +420,22 @@ func (l *List[T]) Min(f func(value T, index int) float64) T {
 
 // Average returns average of list
 func (l *List[T]) Average(f func(value T, index int) float64) float64 {
-	if len(l.slice) == 0 {
+	if len(l.items()) == 0 {
 		return 0
 	}
 
 	sum := 0.0
-	for i, t := range l.slice {
+	for i, t := range l.items() {
 		sum += f(t, i)
 	}
 
-	return sum / float64(len(l.slice))
+	return sum / float64(len(l.items()))
 }
 
 // Sum returns sum of elements
 func (l *List[T]) Sum(f func(value T, index int) float64) float64 {
 	sum := 0.0
-	for i, t := range l.slice {
+	for i, t := range l.items() {
 		sum += f(t, i)
 	}
 
@@ -321,27 +444,55 @@ func (l *List[T]) Sum(f func(value T, index int) float64) float64 {
 
 // ToSlice returns slice of elements
 func (l *List[T]) ToSlice() []T {
-	return l.slice
+	return l.items()
+}
+
+// ToList returns list itself
+func (l *List[T]) ToList() *List[T] {
+	return l
 }
 
 // Reverse returns reversed list
 func (l *List[T]) Reverse() *List[T] {
-	s := make([]T, len(l.slice))
-	for i := 0; i < len(l.slice); i++ {
-		s[i] = l.slice[len(l.slice)-i-1]
+	sp := l.span("Reverse")
+	s := make([]T, len(l.items()))
+	for i := 0; i < len(l.items()); i++ {
+		s[i] = l.items()[len(l.items())-i-1]
 	}
-	return From(s)
+	return sp.end(s)
 }
 
-// Distinct returns list excluding duplicate elements
-func (l *List[T]) Distinct() *List[T] {
-	m := make(map[T]bool)
-	s := make([]T, 0, len(l.slice))
-	for _, t := range l.slice {
-		if _, ok := m[t]; !ok {
-			m[t] = true
-			s = append(s, t)
+// Distinct returns list excluding duplicate elements.
+// Elements are compared by eq, Equal method of T or == in this order.
+// If eq is Hasher, then elements are bucketed by hash instead of compared with each other.
+func (l *List[T]) Distinct(eq ...Equaler[T]) *List[T] {
+	sp := l.span("Distinct")
+	e := equalerOf(eq)
+	s := make([]T, 0, len(l.items()))
+	switch e := e.(type) {
+	case nil:
+		m := make(map[T]struct{}, len(l.items()))
+		for _, t := range l.items() {
+			if _, ok := m[t]; !ok {
+				m[t] = struct{}{}
+				s = append(s, t)
+			}
+		}
+	case Hasher[T]:
+		m := make(map[uint64][]T)
+		for _, t := range l.items() {
+			h := e.Hash(t)
+			if !From(m[h]).Contains(t, e) {
+				m[h] = append(m[h], t)
+				s = append(s, t)
+			}
+		}
+	default:
+		for _, t := range l.items() {
+			if !From(s).Contains(t, e) {
+				s = append(s, t)
+			}
 		}
 	}
-	return From(s)
+	return sp.end(s)
 }
@@ -1,6 +1,9 @@
 package linq
 
 import (
+	"errors"
+	"io"
+	"math/rand/v2"
 	"reflect"
 	"testing"
 )
@@ -117,6 +120,17 @@ func TestList_First(t *testing.T) {
 	}
 }
 
+func TestList_First_Errors(t *testing.T) {
+	if _, err := From([]T{}).First(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("First() error = %v, want %v", err, ErrEmpty)
+	}
+	if _, err := From([]T{1}).Last(func(value T, index int) bool {
+		return false
+	}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Last() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
 func TestList_FirstOrDefault(t *testing.T) {
 	type fields struct {
 		slice []T
@@ -997,7 +1011,7 @@ func TestList_DefaultIfEmpty(t *testing.T) {
 			args: args{
 				defaultT: nil,
 			},
-			want: &List[T]{[]T{1, 2, 3, 4, 5}},
+			want: &List[T]{slice: []T{1, 2, 3, 4, 5}},
 		},
 		{
 			name: "empty",
@@ -1007,7 +1021,7 @@ func TestList_DefaultIfEmpty(t *testing.T) {
 			args: args{
 				defaultT: nil,
 			},
-			want: &List[T]{[]T{0}},
+			want: &List[T]{slice: []T{0}},
 		},
 		{
 			name: "empty with specific default value",
@@ -1017,7 +1031,7 @@ func TestList_DefaultIfEmpty(t *testing.T) {
 			args: args{
 				defaultT: []T{-1},
 			},
-			want: &List[T]{[]T{-1}},
+			want: &List[T]{slice: []T{-1}},
 		},
 	}
 	for _, tt := range tests {
@@ -1556,6 +1570,13 @@ func TestList_Sum(t *testing.T) {
 	}
 }
 
+func TestList_ToList(t *testing.T) {
+	l := From([]T{1, 2, 3})
+	if got := l.ToList(); got != l {
+		t.Errorf("ToList() = %v, want %v", got, l)
+	}
+}
+
 func TestList_Reverse(t *testing.T) {
 	type fields struct {
 		slice []T
@@ -1622,3 +1643,265 @@ func TestList_Distinct(t *testing.T) {
 		})
 	}
 }
+
+func TestEmpty(t *testing.T) {
+	if Empty[T]() != Empty[T]() {
+		t.Errorf("Empty() returned different lists")
+	}
+	if got := Empty[T]().Count(); got != 0 {
+		t.Errorf("Empty().Count() = %v, want %v", got, 0)
+	}
+	if got := any(Empty[string]()); got == any(Empty[T]()) {
+		t.Errorf("Empty() shared list between types")
+	}
+}
+
+func TestList_Nil(t *testing.T) {
+	var l *List[T]
+	empty := From([]T{})
+	f := func(value T, index int) bool {
+		return true
+	}
+	selector := func(value T, index int) float64 {
+		return float64(value)
+	}
+
+	if _, err := l.First(); err == nil {
+		t.Errorf("First() error = nil, want error")
+	}
+	if _, err := l.Last(f); err == nil {
+		t.Errorf("Last() error = nil, want error")
+	}
+	if _, err := l.At(0); err == nil {
+		t.Errorf("At() error = nil, want error")
+	}
+	if l.FirstOrDefault() != 0 || l.LastOrDefault() != 0 || l.AtOrDefault(0) != 0 {
+		t.Errorf("OrDefault() returned non zero value")
+	}
+	for name, got := range map[string]*List[T]{
+		"Skip":      l.Skip(1),
+		"SkipWhile": l.SkipWhile(f),
+		"Take":      l.Take(1),
+		"TakeWhile": l.TakeWhile(f),
+		"Where":     l.Where(f),
+		"Reverse":   l.Reverse(),
+		"Distinct":  l.Distinct(),
+		"ToList":    l.ToList(),
+	} {
+		if got.Count() != 0 || len(got.ToSlice()) != 0 {
+			t.Errorf("%v() = %v, want empty list", name, got)
+		}
+	}
+	if got := l.DefaultIfEmpty(); !reflect.DeepEqual(got.ToSlice(), []T{0}) {
+		t.Errorf("DefaultIfEmpty() = %v, want %v", got, []T{0})
+	}
+	if !l.All(f) || l.Any() || l.Any(f) || l.Contains(0) || l.Count(f) != 0 {
+		t.Errorf("predicates returned unexpected result")
+	}
+	if !l.SequenceEqual(nil) || !l.SequenceEqual(empty) || !empty.SequenceEqual(l) {
+		t.Errorf("SequenceEqual() = false for empty lists")
+	}
+	if From([]T{1}).SequenceEqual(nil) {
+		t.Errorf("SequenceEqual(nil) = true for non empty list")
+	}
+	if l.Max(selector) != 0 || l.Min(selector) != 0 || l.Average(selector) != 0 || l.Sum(selector) != 0 {
+		t.Errorf("aggregates returned non zero value")
+	}
+}
+
+func FuzzList(f *testing.F) {
+	f.Add([]byte{}, 0, true)
+	f.Add([]byte{}, 0, false)
+	f.Add([]byte{1, 2, 2, 3}, 2, false)
+	f.Add([]byte{5, 4, 3}, -1, false)
+	f.Add([]byte{9}, 100, true)
+	f.Fuzz(func(t *testing.T, data []byte, n int, isNil bool) {
+		var l *List[T]
+		if !isNil {
+			s := make([]T, len(data))
+			for i, b := range data {
+				s[i] = T(b)
+			}
+			l = From(s)
+		}
+		var other *List[T]
+		if n%2 == 0 {
+			other = l.Reverse()
+		}
+		exerciseOperators(t, l, other, n)
+	})
+}
+
+// exerciseOperators calls every operator which should not panic on l and other.
+func exerciseOperators(t *testing.T, l, other *List[T], n int) {
+	f := func(value T, index int) bool {
+		return int(value)%3 == n%3
+	}
+	selector := func(value T, index int) float64 {
+		return float64(value)
+	}
+	key := func(value T, index int) T {
+		return value % 3
+	}
+
+	_, _ = l.First(f)
+	_, _ = l.Last()
+	_, _ = l.At(n)
+	_ = l.FirstOrDefault(f)
+	_ = l.LastOrDefault(f)
+	_ = l.AtOrDefault(n)
+	_ = l.Skip(n).Take(n).SkipWhile(f).TakeWhile(f).DefaultIfEmpty()
+	_ = l.Where(f).Reverse().Distinct().Distinct(By(func(value T) T {
+		return value % 2
+	})).ToList().ToSlice()
+	_ = l.All(f) || l.Any() || l.Any(f) || l.Contains(T(n)) || l.SequenceEqual(other)
+	_ = l.Count() + l.Count(f)
+	_ = l.Max(selector) + l.Min(selector)
+	_ = l.Average(selector) + l.Sum(selector)
+
+	_, _ = l.Single(f)
+	_ = l.FirstOpt(f).IsSome() || l.LastOpt().IsSome() || l.AtOpt(n).IsSome() || l.SingleOpt().IsSome()
+	_ = l.MaxOpt(selector).OrElse(0) + l.MinOpt(selector).OrElse(0)
+	_ = l.Shuffle(rand.NewPCG(1, 2)).Sample(n, rand.NewPCG(1, 2))
+	_ = l.SampleWeighted(n, func(value T) float64 { return float64(value) }, rand.NewPCG(1, 2))
+	_, _ = l.Validate(func(value T, index int) error { return nil })
+	_, _ = l.Partition(f)
+	_ = l.Duplicates().SequenceEqual(DistinctBy(l, key, KeepLast))
+	_, _ = l.EditScript(other).Apply(l)
+	_, _ = Diff(l, other, key).Apply(l)
+
+	set := l.ToSet()
+	_ = set.Union(other.ToSet()).Count()
+	_ = set.Union(nil).Intersect(nil).Except(nil).Count()
+	_ = set.IsSubsetOf(nil) || set.IsSupersetOf(nil)
+	var nilSet *Set[T]
+	_ = nilSet.Add(T(n)).Remove(T(n)).Union(set).Count()
+	_ = nilSet.Contains(T(n)) || nilSet.IsSubsetOf(set) || nilSet.Any()
+	_ = l.ToBuilder().AddRange(other.ToSlice()...).Build()
+	sorted := l.OrderBy(compareT)
+	_ = sorted.Union(other.AsSorted(compareT)).Range(T(n), T(n+10))
+	_ = sorted.Union(nil).Intersect(nil)
+	_, _ = sorted.BinarySearch(T(n))
+	_ = sorted.After(n, Cursor[T]{Value: T(n), Offset: n})
+	for _, empty := range []*SortedList[T]{nil, {}} {
+		_, _ = empty.BinarySearch(T(n))
+		_ = empty.LowerBound(T(n)) + empty.UpperBound(T(n))
+		_ = empty.Contains(T(n)) || empty.Contains(T(n), By(func(value T) T { return value % 2 }))
+		_ = empty.Range(T(n), T(n+10)).Union(sorted).Intersect(empty).Union(empty)
+		_ = empty.After(n, Cursor[T]{Value: T(n), Offset: n}).Items
+		_ = empty.Before(n, Cursor[T]{Value: T(n)}).Items
+		_ = sorted.Union(empty).Intersect(empty)
+	}
+	_ = (&SortedList[T]{}).Count() + (&SortedList[T]{}).Reverse().Count()
+	_, _ = l.Paginate(n, n)
+	_ = ToDictionary(l, key, key).Count()
+	_ = ToLookup(l, key).Get(0)
+	var nilDictionary *Dictionary[T, T]
+	_ = nilDictionary.Add(T(n), T(n)).Remove(T(n)).Count() + nilDictionary.Keys().Count() + nilDictionary.Values().Count()
+	_, _ = nilDictionary.TryGet(T(n))
+	_ = nilDictionary.ContainsKey(T(n)) || nilDictionary.Contains(KeyValue[T, T]{}) || nilDictionary.Any()
+	var nilLookup *Lookup[T, T]
+	_ = nilLookup.Add(T(n), T(n)).Remove(T(n)).Count() + nilLookup.Keys().Count() + nilLookup.Get(T(n)).Count()
+	_ = nilLookup.ContainsKey(T(n)) || nilLookup.Contains(KeyValue[T, T]{}) || nilLookup.Any()
+	_ = IndexBy(l, key).Where(0)
+	_ = OrderedIndexBy(l, key, compareT).Range(0, 2)
+	var nilIndex *Index[T, T]
+	_ = nilIndex.Covers(l) || nilIndex.Refresh(l).ContainsKey(0)
+	_, _ = WithIndex(l, nilIndex).FirstKey(0)
+	_ = WithIndex(l, nilIndex).WhereKey(0).Count()
+	var nilOrderedIndex *OrderedIndex[T, T]
+	_ = nilOrderedIndex.Covers(l) || nilOrderedIndex.Refresh(l).Where(0).Any()
+	_, _ = nilOrderedIndex.First(0)
+	_ = nilOrderedIndex.Range(0, 2)
+	_ = Join(l, other, key, key, func(o T, i T) T {
+		return o + i
+	})
+	_ = JoinIndex(l, nil, key, func(o T, i T) T {
+		return o + i
+	})
+	_ = l.WriteJSON(io.Discard)
+	_ = l.WriteJSONLines(io.Discard)
+}
+
+func TestList_FirstOr(t *testing.T) {
+	isEven := func(v T, i int) bool { return v%2 == 0 }
+	tests := []struct {
+		name   string
+		slice  []T
+		filter []func(value T, index int) bool
+		want   T
+	}{
+		{name: "get first element", slice: []T{1, 2, 3}, want: 1},
+		{name: "get first element with function", slice: []T{1, 2, 4}, filter: []func(T, int) bool{isEven}, want: 2},
+		{name: "empty slice", slice: []T{}, want: -1},
+		{name: "element does not exist", slice: []T{1, 3}, filter: []func(T, int) bool{isEven}, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := From(tt.slice).FirstOr(-1, tt.filter...); got != tt.want {
+				t.Errorf("FirstOr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_LastOr(t *testing.T) {
+	isEven := func(v T, i int) bool { return v%2 == 0 }
+	tests := []struct {
+		name   string
+		slice  []T
+		filter []func(value T, index int) bool
+		want   T
+	}{
+		{name: "get last element", slice: []T{1, 2, 3}, want: 3},
+		{name: "get last element with function", slice: []T{2, 4, 5}, filter: []func(T, int) bool{isEven}, want: 4},
+		{name: "empty slice", slice: []T{}, want: -1},
+		{name: "element does not exist", slice: []T{1, 3}, filter: []func(T, int) bool{isEven}, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := From(tt.slice).LastOr(-1, tt.filter...); got != tt.want {
+				t.Errorf("LastOr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_Single(t *testing.T) {
+	isEven := func(v T, i int) bool { return v%2 == 0 }
+	tests := []struct {
+		name    string
+		slice   []T
+		filter  []func(value T, index int) bool
+		want    T
+		wantErr error
+	}{
+		{name: "only element", slice: []T{1}, want: 1},
+		{name: "only matched element", slice: []T{1, 2, 3}, filter: []func(T, int) bool{isEven}, want: 2},
+		{name: "empty slice", slice: []T{}, wantErr: ErrEmpty},
+		{name: "element does not exist", slice: []T{1, 3}, filter: []func(T, int) bool{isEven}, wantErr: ErrNotFound},
+		{name: "more than one element", slice: []T{1, 2}, wantErr: ErrMultiple},
+		{name: "more than one matched element", slice: []T{2, 3, 4}, filter: []func(T, int) bool{isEven}, wantErr: ErrMultiple},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := From(tt.slice).Single(tt.filter...)
+			if !errors.Is(err, tt.wantErr) || err == nil && tt.wantErr != nil {
+				t.Errorf("Single() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Single() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_MustSingle(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("MustSingle() panic = %v, raised %v", err, true)
+		}
+	}()
+	From([]T{1, 2}).MustSingle()
+}
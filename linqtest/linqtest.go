@@ -0,0 +1,308 @@
+// Package linqtest provides property checks for linq.List operators.
+//
+// Laws are algebraic properties and comparisons with reference implementations
+// which hold for any list. They also check that lazy Sequence operators return
+// the same results as List operators. They can be run from Go native fuzz targets:
+//
+//	func FuzzLaws(f *testing.F) {
+//		f.Fuzz(func(t *testing.T, data []byte, n int) {
+//			linqtest.Verify(t, linq.From(data), n, linqtest.Laws(isEven)...)
+//		})
+//	}
+//
+// Packages adding custom operators can define their own Law values and pass them to Verify.
+// Equivalent checks that two lists produced by different paths behave identically.
+package linqtest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/YusukeKishino/go-linq"
+)
+
+// Law is a property which must hold for any list l and any n.
+type Law[T comparable] struct {
+	Name  string
+	Check func(l *linq.List[T], n int) error
+}
+
+// Verify checks laws against l and n and reports violations to t.
+func Verify[T comparable](t testing.TB, l *linq.List[T], n int, laws ...Law[T]) {
+	t.Helper()
+	for _, law := range laws {
+		if err := law.Check(l, n); err != nil {
+			t.Errorf("%v: %v (list = %v, n = %v)", law.Name, err, l.ToSlice(), n)
+		}
+	}
+}
+
+// Laws returns laws of built-in operators.
+// p is used as predicate of operators such as Where and Count.
+func Laws[T comparable](p func(value T, index int) bool) []Law[T] {
+	return []Law[T]{
+		{
+			Name: "Reverse is involution",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Reverse().Reverse().ToSlice(), l.ToSlice())
+			},
+		},
+		{
+			Name: "Reverse matches reference",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Reverse().ToSlice(), Reverse(l.ToSlice()))
+			},
+		},
+		{
+			Name: "Where matches reference",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Where(p).ToSlice(), Where(l.ToSlice(), p))
+			},
+		},
+		{
+			Name: "Where(p).Count() equals Count(p)",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Where(p).Count(), l.Count(p))
+			},
+		},
+		{
+			Name: "Any(p) equals Count(p) > 0",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Any(p), l.Count(p) > 0)
+			},
+		},
+		{
+			Name: "All(p) equals Count(p) == Count()",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.All(p), l.Count(p) == l.Count())
+			},
+		},
+		{
+			Name: "First(p) is first element of Where(p)",
+			Check: func(l *linq.List[T], n int) error {
+				got, err := l.First(p)
+				want := Where(l.ToSlice(), p)
+				if len(want) == 0 {
+					if err == nil {
+						return fmt.Errorf("got %v, want error", got)
+					}
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				return equal(got, want[0])
+			},
+		},
+		{
+			Name: "Take(n) and Skip(n) partition list",
+			Check: func(l *linq.List[T], n int) error {
+				got := append(append([]T{}, l.Take(n).ToSlice()...), l.Skip(n).ToSlice()...)
+				return equal(got, l.ToSlice())
+			},
+		},
+		{
+			Name: "Skip matches reference",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Skip(n).ToSlice(), Skip(l.ToSlice(), n))
+			},
+		},
+		{
+			Name: "Take matches reference",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Take(n).ToSlice(), Take(l.ToSlice(), n))
+			},
+		},
+		{
+			Name: "Distinct is idempotent",
+			Check: func(l *linq.List[T], n int) error {
+				d := l.Distinct()
+				return equal(d.Distinct().ToSlice(), d.ToSlice())
+			},
+		},
+		{
+			Name: "Distinct matches reference",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Distinct().ToSlice(), Distinct(l.ToSlice()))
+			},
+		},
+		{
+			Name: "Distinct().Count() equals ToSet().Count()",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.Distinct().Count(), l.ToSet().Count())
+			},
+		},
+		{
+			Name: "Contains(v) holds for every element",
+			Check: func(l *linq.List[T], n int) error {
+				for _, v := range l.ToSlice() {
+					if !l.Contains(v) {
+						return fmt.Errorf("Contains(%v) = false", v)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Name: "pages concatenate to list",
+			Check: func(l *linq.List[T], n int) error {
+				size := n % 10
+				if size < 0 {
+					size = -size
+				}
+				size++
+				var got []T
+				for page := 1; ; page++ {
+					p, err := l.Paginate(page, size)
+					if err != nil {
+						return err
+					}
+					got = append(got, p.Items...)
+					if !p.HasNext {
+						break
+					}
+				}
+				return equal(got, l.ToSlice())
+			},
+		},
+		{
+			Name: "ToBuilder().Build() equals list",
+			Check: func(l *linq.List[T], n int) error {
+				return equal(l.ToBuilder().Build().ToSlice(), l.ToSlice())
+			},
+		},
+		sequenceLaw("Where", func(l *linq.List[T], n int) any { return l.Where(p).ToSlice() },
+			func(s *linq.Sequence[T], n int) any { return s.Where(p).ToSlice() }),
+		sequenceLaw("Skip", func(l *linq.List[T], n int) any { return l.Skip(n).ToSlice() },
+			func(s *linq.Sequence[T], n int) any { return s.Skip(n).ToSlice() }),
+		sequenceLaw("Take", func(l *linq.List[T], n int) any { return l.Take(n).ToSlice() },
+			func(s *linq.Sequence[T], n int) any { return s.Take(n).ToSlice() }),
+		sequenceLaw("SkipWhile", func(l *linq.List[T], n int) any { return l.SkipWhile(p).ToSlice() },
+			func(s *linq.Sequence[T], n int) any { return s.SkipWhile(p).ToSlice() }),
+		sequenceLaw("TakeWhile", func(l *linq.List[T], n int) any { return l.TakeWhile(p).ToSlice() },
+			func(s *linq.Sequence[T], n int) any { return s.TakeWhile(p).ToSlice() }),
+		sequenceLaw("Skip(n).Take(n)", func(l *linq.List[T], n int) any { return l.Skip(n).Take(n).ToSlice() },
+			func(s *linq.Sequence[T], n int) any { return s.Skip(n).Take(n).ToSlice() }),
+		sequenceLaw("First", func(l *linq.List[T], n int) any { return pair(l.First(p)) },
+			func(s *linq.Sequence[T], n int) any { return pair(s.First(p)) }),
+		sequenceLaw("Count", func(l *linq.List[T], n int) any { return []int{l.Count(), l.Count(p)} },
+			func(s *linq.Sequence[T], n int) any { return []int{s.Count(), s.Count(p)} }),
+		sequenceLaw("Any and All", func(l *linq.List[T], n int) any { return []bool{l.Any(), l.Any(p), l.All(p)} },
+			func(s *linq.Sequence[T], n int) any { return []bool{s.Any(), s.Any(p), s.All(p)} }),
+		sequenceLaw("Contains", func(l *linq.List[T], n int) any { return l.Contains(l.AtOrDefault(n)) },
+			func(s *linq.Sequence[T], n int) any { return s.Contains(s.ToList().AtOrDefault(n)) }),
+	}
+}
+
+// sequenceLaw returns law that operator of Sequence made by AsSequence returns the same result as List.
+func sequenceLaw[T comparable](op string, list func(l *linq.List[T], n int) any, seq func(s *linq.Sequence[T], n int) any) Law[T] {
+	return Law[T]{
+		Name: fmt.Sprintf("AsSequence().%v matches List", op),
+		Check: func(l *linq.List[T], n int) error {
+			return equal(seq(l.AsSequence(), n), list(l, n))
+		},
+	}
+}
+
+// pair returns value and error as a comparable slice.
+func pair[T any](value T, err error) []any {
+	return []any{value, err}
+}
+
+// Equivalent reports to t if operators return different results for want and got.
+// It can compare lists built by different paths, e.g. From and FromChan.
+func Equivalent[T comparable](t testing.TB, want, got *linq.List[T], p func(value T, index int) bool) {
+	t.Helper()
+	checks := []struct {
+		name string
+		f    func(l *linq.List[T]) any
+	}{
+		{"ToSlice", func(l *linq.List[T]) any { return l.ToSlice() }},
+		{"Count", func(l *linq.List[T]) any { return l.Count() }},
+		{"Where", func(l *linq.List[T]) any { return l.Where(p).ToSlice() }},
+		{"Reverse", func(l *linq.List[T]) any { return l.Reverse().ToSlice() }},
+		{"Distinct", func(l *linq.List[T]) any { return l.Distinct().ToSlice() }},
+		{"Any", func(l *linq.List[T]) any { return l.Any(p) }},
+		{"All", func(l *linq.List[T]) any { return l.All(p) }},
+		{"FirstOrDefault", func(l *linq.List[T]) any { return l.FirstOrDefault(p) }},
+		{"LastOrDefault", func(l *linq.List[T]) any { return l.LastOrDefault(p) }},
+	}
+	for _, c := range checks {
+		if err := equal(c.f(got), c.f(want)); err != nil {
+			t.Errorf("%v: %v", c.name, err)
+		}
+	}
+}
+
+// Reverse is reference implementation of List.Reverse.
+func Reverse[T comparable](s []T) []T {
+	r := []T{}
+	for i := len(s) - 1; i >= 0; i-- {
+		r = append(r, s[i])
+	}
+	return r
+}
+
+// Where is reference implementation of List.Where.
+func Where[T comparable](s []T, p func(value T, index int) bool) []T {
+	r := []T{}
+	for i, v := range s {
+		if p(v, i) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Skip is reference implementation of List.Skip.
+func Skip[T comparable](s []T, n int) []T {
+	r := []T{}
+	for i, v := range s {
+		if i >= n {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Take is reference implementation of List.Take.
+func Take[T comparable](s []T, n int) []T {
+	r := []T{}
+	for i, v := range s {
+		if i < n {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Distinct is reference implementation of List.Distinct.
+func Distinct[T comparable](s []T) []T {
+	r := []T{}
+	for i, v := range s {
+		seen := false
+		for _, w := range s[:i] {
+			if v == w {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// equal compares values treating nil and empty slices as equal.
+func equal(got, want any) error {
+	gv, wv := reflect.ValueOf(got), reflect.ValueOf(want)
+	if gv.Kind() == reflect.Slice && wv.Kind() == reflect.Slice && gv.Len() == 0 && wv.Len() == 0 {
+		return nil
+	}
+	if !reflect.DeepEqual(got, want) {
+		return fmt.Errorf("got %v, want %v", got, want)
+	}
+	return nil
+}
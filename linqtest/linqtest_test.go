@@ -0,0 +1,111 @@
+package linqtest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/YusukeKishino/go-linq"
+)
+
+func isEven(value byte, index int) bool {
+	return value%2 == 0
+}
+
+func seeds(f *testing.F) {
+	f.Add([]byte{}, 0)
+	f.Add([]byte{1, 2, 3, 4, 5}, 2)
+	f.Add([]byte{1, 1, 2, 2, 1}, -3)
+	f.Add([]byte{7}, 100)
+}
+
+func FuzzLaws(f *testing.F) {
+	seeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n int) {
+		Verify(t, linq.From(data), n, Laws(isEven)...)
+	})
+}
+
+func FuzzEquivalent(f *testing.F) {
+	seeds(f)
+	f.Fuzz(func(t *testing.T, data []byte, n int) {
+		want := linq.From(data)
+
+		Equivalent(t, want, linq.FromChan(want.ToChan(context.Background(), n&7)), isEven)
+		Equivalent(t, want, linq.NewListBuilder[byte]().AddRange(data...).Build(), isEven)
+
+		b := linq.NewListBuilder[int](len(data))
+		for _, v := range data {
+			b.Add(int(v))
+		}
+		ints := b.Build()
+		var buf bytes.Buffer
+		if err := ints.WriteJSONLines(&buf); err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := linq.FromJSONLines[int](&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		Equivalent(t, ints, decoded, func(value int, index int) bool {
+			return value%2 == 0
+		})
+	})
+}
+
+func TestVerify_ReportsViolation(t *testing.T) {
+	broken := Law[byte]{
+		Name: "Reverse is identity",
+		Check: func(l *linq.List[byte], n int) error {
+			return equal(l.Reverse().ToSlice(), l.ToSlice())
+		},
+	}
+
+	r := &recorder{}
+	Verify(r, linq.From([]byte{1, 2}), 0, broken)
+	if len(r.errors) != 1 {
+		t.Errorf("Verify() reported %v errors, want %v", len(r.errors), 1)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name    string
+		got     any
+		want    any
+		wantErr error
+	}{
+		{
+			name: "nil and empty slices",
+			got:  []int(nil),
+			want: []int{},
+		},
+		{
+			name:    "different values",
+			got:     1,
+			want:    2,
+			wantErr: errors.New("got 1, want 2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := equal(tt.got, tt.want)
+			if (err == nil) != (tt.wantErr == nil) || err != nil && err.Error() != tt.wantErr.Error() {
+				t.Errorf("equal() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// recorder is testing.TB which records errors instead of failing.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, format)
+}
@@ -0,0 +1,135 @@
+package linq
+
+// Lookup is immutable map from key to multiple values which keeps insertion order of keys.
+type Lookup[K comparable, V comparable] struct {
+	keys []K
+	m    map[K][]V
+}
+
+// NewLookup is constructor of Lookup.
+func NewLookup[K comparable, V comparable](pairs ...KeyValue[K, V]) *Lookup[K, V] {
+	lookup := &Lookup[K, V]{
+		m: make(map[K][]V),
+	}
+	for _, p := range pairs {
+		if _, ok := lookup.m[p.Key]; !ok {
+			lookup.keys = append(lookup.keys, p.Key)
+		}
+		lookup.m[p.Key] = append(lookup.m[p.Key], p.Value)
+	}
+
+	return lookup
+}
+
+// ToLookup returns lookup of elements grouped by key
+func ToLookup[T comparable, K comparable](l *List[T], key func(value T, index int) K) *Lookup[K, T] {
+	pairs := make([]KeyValue[K, T], len(l.items()))
+	for i, t := range l.items() {
+		pairs[i] = KeyValue[K, T]{Key: key(t, i), Value: t}
+	}
+
+	return NewLookup(pairs...)
+}
+
+// items returns keys of lookup and values of each key. Nil lookup is empty.
+func (lookup *Lookup[K, V]) items() ([]K, map[K][]V) {
+	if lookup == nil {
+		return nil, nil
+	}
+	return lookup.keys, lookup.m
+}
+
+// ToList returns list of key value pairs grouped by key, in order in which keys were first added
+func (lookup *Lookup[K, V]) ToList() *List[KeyValue[K, V]] {
+	keys, m := lookup.items()
+	s := make([]KeyValue[K, V], 0, len(keys))
+	for _, k := range keys {
+		for _, v := range m[k] {
+			s = append(s, KeyValue[K, V]{Key: k, Value: v})
+		}
+	}
+
+	return From(s)
+}
+
+// First gets first pair of Lookup.
+// If pair is not found, then it returns error.
+func (lookup *Lookup[K, V]) First(filter ...func(value KeyValue[K, V], index int) bool) (KeyValue[K, V], error) {
+	return lookup.ToList().First(filter...)
+}
+
+// Where returns condition matched pairs
+func (lookup *Lookup[K, V]) Where(f func(value KeyValue[K, V], index int) bool) *List[KeyValue[K, V]] {
+	return lookup.ToList().Where(f)
+}
+
+// All returns true if all pairs are matched
+func (lookup *Lookup[K, V]) All(f func(value KeyValue[K, V], index int) bool) bool {
+	return lookup.ToList().All(f)
+}
+
+// Any returns true if there is matched pair
+func (lookup *Lookup[K, V]) Any(f ...func(value KeyValue[K, V], index int) bool) bool {
+	return lookup.ToList().Any(f...)
+}
+
+// Contains returns true if lookup has the pair.
+// Without eq, it looks up key of the pair by ==.
+func (lookup *Lookup[K, V]) Contains(pair KeyValue[K, V], eq ...Equaler[KeyValue[K, V]]) bool {
+	if len(eq) > 0 {
+		return lookup.ToList().Contains(pair, eq...)
+	}
+
+	_, m := lookup.items()
+	return From(m[pair.Key]).Contains(pair.Value)
+}
+
+// Count returns number of pair
+func (lookup *Lookup[K, V]) Count(f ...func(value KeyValue[K, V], index int) bool) int {
+	return lookup.ToList().Count(f...)
+}
+
+// Get returns values of key.
+// If key is not found, then it returns empty list.
+func (lookup *Lookup[K, V]) Get(key K) *List[V] {
+	values, _ := lookup.TryGet(key)
+	return values
+}
+
+// TryGet returns values of key.
+// If key is not found, then it returns empty list and false.
+func (lookup *Lookup[K, V]) TryGet(key K) (*List[V], bool) {
+	_, m := lookup.items()
+	values, ok := m[key]
+	if !ok {
+		return From([]V{}), false
+	}
+
+	return From(values[:len(values):len(values)]), true
+}
+
+// ContainsKey returns true if lookup has key
+func (lookup *Lookup[K, V]) ContainsKey(key K) bool {
+	_, m := lookup.items()
+	_, ok := m[key]
+	return ok
+}
+
+// Keys returns list of keys in insertion order
+func (lookup *Lookup[K, V]) Keys() *List[K] {
+	keys, _ := lookup.items()
+	return From(keys[:len(keys):len(keys)])
+}
+
+// Add returns lookup with value appended to key
+func (lookup *Lookup[K, V]) Add(key K, value V) *Lookup[K, V] {
+	return NewLookup(append(lookup.ToList().slice, KeyValue[K, V]{Key: key, Value: value})...)
+}
+
+// Remove returns lookup without keys
+func (lookup *Lookup[K, V]) Remove(keys ...K) *Lookup[K, V] {
+	removed := NewSet(keys...)
+	return NewLookup(lookup.Where(func(value KeyValue[K, V], index int) bool {
+		return !removed.Contains(value.Key)
+	}).slice...)
+}
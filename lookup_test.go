@@ -0,0 +1,121 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func parity(value T, index int) string {
+	if value%2 == 0 {
+		return "even"
+	}
+	return "odd"
+}
+
+func TestToLookup(t *testing.T) {
+	got := ToLookup(From([]T{1, 2, 3, 4, 5}), parity)
+
+	want := []kv{{"odd", 1}, {"odd", 3}, {"odd", 5}, {"even", 2}, {"even", 4}}
+	if !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("ToLookup() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+}
+
+func TestLookup_TryGet(t *testing.T) {
+	lookup := ToLookup(From([]T{1, 2, 3}), parity)
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   *List[T]
+		wantOK bool
+	}{
+		{
+			name: "existing key",
+			args: args{
+				key: "odd",
+			},
+			want: &List[T]{
+				slice: []T{1, 3},
+			},
+			wantOK: true,
+		},
+		{
+			name: "missing key",
+			args: args{
+				key: "none",
+			},
+			want: &List[T]{
+				slice: []T{},
+			},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := lookup.TryGet(tt.args.key)
+			if !got.SequenceEqual(tt.want) || ok != tt.wantOK {
+				t.Errorf("TryGet() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+			if got := lookup.Get(tt.args.key); !got.SequenceEqual(tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+			if ok := lookup.ContainsKey(tt.args.key); ok != tt.wantOK {
+				t.Errorf("ContainsKey() = %v, want %v", ok, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestLookup_Add(t *testing.T) {
+	lookup := ToLookup(From([]T{1, 2}), parity)
+	got := lookup.Add("odd", 7).Add("big", 100)
+
+	want := []kv{{"odd", 1}, {"odd", 7}, {"even", 2}, {"big", 100}}
+	if !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("Add() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+	if lookup.Count() != 2 {
+		t.Errorf("Add() changed original lookup")
+	}
+}
+
+func TestLookup_Remove(t *testing.T) {
+	lookup := ToLookup(From([]T{1, 2, 3}), parity)
+	got := lookup.Remove("odd")
+
+	if want := []kv{{"even", 2}}; !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("Remove() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+	if !lookup.ContainsKey("odd") {
+		t.Errorf("Remove() changed original lookup")
+	}
+}
+
+func TestLookup_Query(t *testing.T) {
+	lookup := ToLookup(From([]T{1, 2, 3, 4}), parity)
+	big := func(value kv, index int) bool {
+		return value.Value > 2
+	}
+
+	if got := lookup.Where(big).ToSlice(); !reflect.DeepEqual(got, []kv{{"odd", 3}, {"even", 4}}) {
+		t.Errorf("Where() = %v", got)
+	}
+	if got := lookup.Count(big); got != 2 {
+		t.Errorf("Count() = %v, want %v", got, 2)
+	}
+	if got, err := lookup.First(big); err != nil || got != (kv{"odd", 3}) {
+		t.Errorf("First() = %v, %v", got, err)
+	}
+	if !lookup.Contains(kv{"even", 4}) || lookup.Contains(kv{"even", 3}) {
+		t.Errorf("Contains() returned unexpected result")
+	}
+	if !lookup.Any(big) || lookup.All(big) {
+		t.Errorf("Any() or All() returned unexpected result")
+	}
+	if got := lookup.Keys().ToSlice(); !reflect.DeepEqual(got, []string{"odd", "even"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+}
@@ -0,0 +1,159 @@
+package linq
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// source reads elements of Sequence one by one.
+// Callers must hold lock of the struct which embeds it.
+type source[T comparable] struct {
+	seq  iter.Seq[T]
+	pull *pull[T]
+	done bool
+}
+
+// pull is suspended enumeration of source.
+// It is allocated apart from source so that it can be stopped by cleanup of the struct which embeds source.
+type pull[T comparable] struct {
+	next func() (T, bool)
+	stop func()
+}
+
+func sourceOf[T comparable](seq iter.Seq[T]) source[T] {
+	return source[T]{seq: seq, pull: &pull[T]{}}
+}
+
+func (s *source[T]) read() (T, bool) {
+	if s.done {
+		return *new(T), false
+	}
+	if s.pull.next == nil {
+		s.pull.next, s.pull.stop = iter.Pull(s.seq)
+	}
+	t, ok := s.pull.next()
+	if !ok {
+		s.done = true
+		s.pull.close()
+	}
+
+	return t, ok
+}
+
+// close stops suspended enumeration, if any.
+func (p *pull[T]) close() {
+	if p.stop != nil {
+		p.stop()
+		p.next, p.stop = nil, nil
+	}
+}
+
+// memo is elements of Sequence read so far.
+type memo[T comparable] struct {
+	mu sync.Mutex
+	source[T]
+	items []T
+}
+
+// at returns element at index, reading source until it is available.
+func (m *memo[T]) at(index int) (T, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if index < len(m.items) {
+		return m.items[index], true
+	}
+	t, ok := m.read()
+	if ok {
+		m.items = append(m.items, t)
+	}
+
+	return t, ok
+}
+
+// Memoize returns sequence which caches elements on first enumeration.
+// Source is read at most once and only as far as the furthest enumeration, so expensive
+// predicates and single-pass sources like FromLines can be enumerated many times.
+// It is safe to enumerate the returned sequence from multiple goroutines.
+// If it is not enumerated to the end, then source is left suspended until the
+// returned sequence and sequences made from it become unreachable.
+func (s *Sequence[T]) Memoize() *Sequence[T] {
+	m := &memo[T]{source: sourceOf(s.Seq())}
+	runtime.AddCleanup(m, (*pull[T]).close, m.pull)
+	return FromSeq(func(yield func(T) bool) {
+		for i := 0; ; i++ {
+			t, ok := m.at(i)
+			if !ok || !yield(t) {
+				return
+			}
+		}
+	})
+}
+
+// share is source read by multiple consumers.
+type share[T comparable] struct {
+	mu sync.Mutex
+	source[T]
+	// buf is elements which some consumer has not read yet. buf[0] is element at offset.
+	buf    []T
+	offset int
+	// pos is index of next element of each consumer.
+	pos []int
+}
+
+func (s *share[T]) read(consumer int) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.pos[consumer] - s.offset
+	if i == len(s.buf) {
+		t, ok := s.source.read()
+		if !ok {
+			return t, false
+		}
+		s.buf = append(s.buf, t)
+	}
+	t := s.buf[i]
+	s.pos[consumer]++
+
+	low := s.pos[0]
+	for _, p := range s.pos[1:] {
+		low = min(low, p)
+	}
+	if n := low - s.offset; n > 0 {
+		clear(s.buf[:n])
+		s.buf = s.buf[n:]
+		s.offset = low
+	}
+
+	return t, true
+}
+
+// Share returns consumers sequences which read source once.
+// Each consumer sees all elements, and only elements which some consumer has not read
+// yet are buffered. Enumerating a consumer again continues from where it stopped.
+// It is safe to enumerate consumers from different goroutines.
+// Source which is not read to the end is stopped when all consumers become unreachable.
+// If consumers is lower than 1, then it returns no sequence.
+func (s *Sequence[T]) Share(consumers int) []*Sequence[T] {
+	if consumers < 1 {
+		return []*Sequence[T]{}
+	}
+
+	sh := &share[T]{source: sourceOf(s.Seq()), pos: make([]int, consumers)}
+	runtime.AddCleanup(sh, (*pull[T]).close, sh.pull)
+	seqs := make([]*Sequence[T], consumers)
+	for c := range seqs {
+		seqs[c] = FromSeq(func(yield func(T) bool) {
+			for {
+				t, ok := sh.read(c)
+				if !ok || !yield(t) {
+					return
+				}
+			}
+		})
+	}
+
+	return seqs
+}
@@ -0,0 +1,184 @@
+package linq
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingSeq returns sequence of 0 to n-1 and number of elements read from it.
+func countingSeq(n int) (*Sequence[T], *int) {
+	read := 0
+	return FromSeq(func(yield func(T) bool) {
+		for i := 0; i < n; i++ {
+			read++
+			if !yield(T(i)) {
+				return
+			}
+		}
+	}), &read
+}
+
+func TestSequence_Memoize(t *testing.T) {
+	seq, read := countingSeq(5)
+	m := seq.Memoize()
+
+	if got := m.Take(2).ToSlice(); !reflect.DeepEqual(got, []T{0, 1}) {
+		t.Errorf("Take() = %v", got)
+	}
+	if *read != 2 {
+		t.Errorf("source read %v elements, want %v", *read, 2)
+	}
+	for i := 0; i < 2; i++ {
+		if got := m.ToSlice(); !reflect.DeepEqual(got, []T{0, 1, 2, 3, 4}) {
+			t.Errorf("ToSlice() = %v", got)
+		}
+	}
+	if *read != 5 {
+		t.Errorf("source read %v elements, want %v", *read, 5)
+	}
+}
+
+func TestSequence_Memoize_SinglePass(t *testing.T) {
+	lines, _ := FromLines(strings.NewReader("a\nb\nc\n"))
+	m := lines.Memoize()
+	if got := m.Count(); got != 3 {
+		t.Errorf("Count() = %v, want %v", got, 3)
+	}
+	if got := m.JoinStrings(","); got != "a,b,c" {
+		t.Errorf("JoinStrings() = %v, want %v", got, "a,b,c")
+	}
+}
+
+func TestSequence_Memoize_Concurrent(t *testing.T) {
+	seq, read := countingSeq(1000)
+	m := seq.Memoize()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(take int) {
+			defer wg.Done()
+			got := m.Take(take).ToSlice()
+			for j, v := range got {
+				if v != T(j) {
+					t.Errorf("element %v = %v", j, v)
+					return
+				}
+			}
+			if len(got) != take {
+				t.Errorf("Take(%v) = %v elements", take, len(got))
+			}
+		}(i * 100)
+	}
+	wg.Wait()
+	if *read != 700 {
+		t.Errorf("source read %v elements, want %v", *read, 700)
+	}
+}
+
+func TestSequence_Share(t *testing.T) {
+	seq, read := countingSeq(5)
+	consumers := seq.Share(2)
+	if len(consumers) != 2 {
+		t.Fatalf("Share() = %v consumers, want %v", len(consumers), 2)
+	}
+
+	if got := consumers[0].Take(3).ToSlice(); !reflect.DeepEqual(got, []T{0, 1, 2}) {
+		t.Errorf("first consumer = %v", got)
+	}
+	if got := consumers[1].ToSlice(); !reflect.DeepEqual(got, []T{0, 1, 2, 3, 4}) {
+		t.Errorf("second consumer = %v", got)
+	}
+	if got := consumers[0].ToSlice(); !reflect.DeepEqual(got, []T{3, 4}) {
+		t.Errorf("first consumer continued = %v", got)
+	}
+	if *read != 5 {
+		t.Errorf("source read %v elements, want %v", *read, 5)
+	}
+}
+
+func TestSequence_Share_Interleaved(t *testing.T) {
+	seq, _ := countingSeq(100)
+	consumers := seq.Share(2)
+
+	var got0, got1 []T
+	for i := 0; i < 100; i++ {
+		got0 = append(got0, consumers[0].Take(1).ToSlice()...)
+		got1 = append(got1, consumers[1].Take(1).ToSlice()...)
+	}
+	if len(got0) != 100 || !reflect.DeepEqual(got0, got1) {
+		t.Errorf("consumers = %v, %v", got0, got1)
+	}
+}
+
+func TestSequence_Share_Concurrent(t *testing.T) {
+	seq, read := countingSeq(1000)
+	consumers := seq.Share(4)
+
+	sums := make([]int, len(consumers))
+	var wg sync.WaitGroup
+	for i, c := range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range c.Seq() {
+				sums[i] += int(v)
+			}
+		}()
+	}
+	wg.Wait()
+	for i, sum := range sums {
+		if sum != 999*1000/2 {
+			t.Errorf("consumer %v sum = %v", i, sum)
+		}
+	}
+	if *read != 1000 {
+		t.Errorf("source read %v elements, want %v", *read, 1000)
+	}
+}
+
+func TestSequence_Share_NoConsumer(t *testing.T) {
+	seq, _ := countingSeq(1)
+	if got := seq.Share(0); len(got) != 0 {
+		t.Errorf("Share() = %v consumers, want 0", len(got))
+	}
+}
+
+func TestSequence_Memoize_Unreachable(t *testing.T) {
+	checkGoroutines(t)
+	stopped := make(chan struct{}, 2)
+	source := func() *Sequence[T] {
+		return FromSeq(func(yield func(T) bool) {
+			defer func() { stopped <- struct{}{} }()
+			for i := 0; ; i++ {
+				if !yield(T(i)) {
+					return
+				}
+			}
+		})
+	}
+	func() {
+		if got := source().Memoize().Take(2).Count(); got != 2 {
+			t.Errorf("Memoize().Take() = %v elements, want %v", got, 2)
+		}
+		if got := source().Share(2)[0].Take(2).Count(); got != 2 {
+			t.Errorf("Share().Take() = %v elements, want %v", got, 2)
+		}
+	}()
+
+	deadline := time.After(time.Second)
+	for n := 0; n < 2; {
+		runtime.GC()
+		select {
+		case <-stopped:
+			n++
+		case <-deadline:
+			t.Fatalf("%v of 2 sources stopped", n)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package linq
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Option is value which may be absent.
+// Zero value of Option is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns Option which has value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// None returns Option which has no value.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Get returns value and true, or zero value and false if Option is None.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// IsSome returns true if Option has value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if Option has no value.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// OrElse returns value, or defaultT if Option is None.
+func (o Option[T]) OrElse(defaultT T) T {
+	if !o.ok {
+		return defaultT
+	}
+	return o.value
+}
+
+// String returns value formatted by fmt, or "None".
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// MarshalJSON encodes value, or null if Option is None.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and others as value.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+
+	return nil
+}
+
+// MapOption returns Option of f applied to value, or None if o is None.
+func MapOption[T any, R any](o Option[T], f func(value T) R) Option[R] {
+	if !o.ok {
+		return None[R]()
+	}
+	return Some(f(o.value))
+}
+
+// optionOf returns Some(value) if err is nil, or None.
+func optionOf[T any](value T, err error) Option[T] {
+	if err != nil {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// FirstOpt gets first element of List.
+// If element is not found, then it returns None.
+func (l *List[T]) FirstOpt(filter ...func(value T, index int) bool) Option[T] {
+	return optionOf(l.First(filter...))
+}
+
+// LastOpt gets last element of List.
+// If element is not found, then it returns None.
+func (l *List[T]) LastOpt(filter ...func(value T, index int) bool) Option[T] {
+	return optionOf(l.Last(filter...))
+}
+
+// AtOpt returns specific element by index.
+// If element is not found, then it returns None.
+func (l *List[T]) AtOpt(index int) Option[T] {
+	if index < 0 || len(l.items()) <= index {
+		return None[T]()
+	}
+	return Some(l.items()[index])
+}
+
+// SingleOpt gets the only element of List.
+// If element is not found or there are more than one elements, then it returns None.
+func (l *List[T]) SingleOpt(filter ...func(value T, index int) bool) Option[T] {
+	return optionOf(l.Single(filter...))
+}
+
+// MaxOpt returns maximum element of list.
+// If list is empty, then it returns None.
+func (l *List[T]) MaxOpt(f func(value T, index int) float64) Option[T] {
+	if len(l.items()) == 0 {
+		return None[T]()
+	}
+	return Some(l.Max(f))
+}
+
+// MinOpt returns minimum element of list.
+// If list is empty, then it returns None.
+func (l *List[T]) MinOpt(f func(value T, index int) float64) Option[T] {
+	if len(l.items()) == 0 {
+		return None[T]()
+	}
+	return Some(l.Min(f))
+}
@@ -0,0 +1,106 @@
+package linq
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOption(t *testing.T) {
+	some := Some(0)
+	if v, ok := some.Get(); v != 0 || !ok || !some.IsSome() || some.IsNone() {
+		t.Errorf("Some(0) = %v, %v", v, ok)
+	}
+	if got := some.OrElse(-1); got != 0 {
+		t.Errorf("OrElse() = %v, want %v", got, 0)
+	}
+
+	var none Option[int]
+	if none != None[int]() {
+		t.Errorf("zero value = %v, want None", none)
+	}
+	if v, ok := none.Get(); v != 0 || ok || none.IsSome() || !none.IsNone() {
+		t.Errorf("None() = %v, %v", v, ok)
+	}
+	if got := none.OrElse(-1); got != -1 {
+		t.Errorf("OrElse() = %v, want %v", got, -1)
+	}
+
+	if got := some.String() + " " + none.String(); got != "Some(0) None" {
+		t.Errorf("String() = %v", got)
+	}
+}
+
+func TestMapOption(t *testing.T) {
+	letter := func(v int) string { return string(rune('a' + v*2)) }
+	if got := MapOption(Some(1), letter); got != Some("c") {
+		t.Errorf("MapOption() = %v, want %v", got, Some("c"))
+	}
+	if got := MapOption(None[int](), letter); got != None[string]() {
+		t.Errorf("MapOption() = %v, want None", got)
+	}
+}
+
+func TestOption_JSON(t *testing.T) {
+	type Row struct {
+		Score Option[int] `json:"score"`
+		Name  Option[string]
+	}
+	tests := []struct {
+		name string
+		row  Row
+		json string
+	}{
+		{name: "some", row: Row{Score: Some(0), Name: Some("a")}, json: `{"score":0,"Name":"a"}`},
+		{name: "none", row: Row{}, json: `{"score":null,"Name":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.row)
+			if err != nil || string(b) != tt.json {
+				t.Errorf("Marshal() = %s, %v, want %v", b, err, tt.json)
+			}
+			var got Row
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil || !reflect.DeepEqual(got, tt.row) {
+				t.Errorf("Unmarshal() = %v, %v, want %v", got, err, tt.row)
+			}
+		})
+	}
+
+	var o Option[int]
+	if err := json.Unmarshal([]byte(`"x"`), &o); err == nil {
+		t.Errorf("Unmarshal() error = nil, want error")
+	}
+}
+
+func TestList_Opt(t *testing.T) {
+	l := From([]T{0, 1, 2})
+	empty := From([]T{})
+	isEven := func(v T, i int) bool { return v%2 == 0 }
+	toFloat := func(v T, i int) float64 { return float64(v) }
+	tests := []struct {
+		name string
+		got  Option[T]
+		want Option[T]
+	}{
+		{name: "FirstOpt finds zero value", got: l.FirstOpt(), want: Some[T](0)},
+		{name: "FirstOpt on empty list", got: empty.FirstOpt(), want: None[T]()},
+		{name: "LastOpt with function", got: l.LastOpt(isEven), want: Some[T](2)},
+		{name: "LastOpt not found", got: l.LastOpt(func(v T, i int) bool { return v > 2 }), want: None[T]()},
+		{name: "AtOpt", got: l.AtOpt(1), want: Some[T](1)},
+		{name: "AtOpt out of index", got: l.AtOpt(3), want: None[T]()},
+		{name: "SingleOpt", got: l.SingleOpt(func(v T, i int) bool { return v == 1 }), want: Some[T](1)},
+		{name: "SingleOpt with more than one element", got: l.SingleOpt(isEven), want: None[T]()},
+		{name: "MaxOpt", got: l.MaxOpt(toFloat), want: Some[T](2)},
+		{name: "MaxOpt on empty list", got: empty.MaxOpt(toFloat), want: None[T]()},
+		{name: "MinOpt", got: l.MinOpt(toFloat), want: Some[T](0)},
+		{name: "MinOpt on nil list", got: (*List[T])(nil).MinOpt(toFloat), want: None[T]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package linq
+
+import (
+	"fmt"
+)
+
+// Page is a page of List with metadata.
+type Page[T comparable] struct {
+	Items      []T  `json:"items"`
+	Page       int  `json:"page"`
+	Size       int  `json:"size"`
+	TotalCount int  `json:"total_count"`
+	TotalPages int  `json:"total_pages"`
+	HasNext    bool `json:"has_next"`
+	HasPrev    bool `json:"has_prev"`
+}
+
+// Paginate returns page of elements. page starts from 1.
+// If page or size is lower than 1, then it returns error.
+func (l *List[T]) Paginate(page, size int) (*Page[T], error) {
+	if page < 1 {
+		return nil, fmt.Errorf("invalid page: %v", page)
+	}
+	if size < 1 {
+		return nil, fmt.Errorf("invalid page size: %v", size)
+	}
+
+	total := len(l.items())
+	totalPages := total / size
+	if total%size != 0 {
+		totalPages++
+	}
+	items := make([]T, 0)
+	if page <= totalPages {
+		items = append(items, l.Skip((page-1)*size).Take(size).items()...)
+	}
+
+	return &Page[T]{
+		Items:      items,
+		Page:       page,
+		Size:       size,
+		TotalCount: total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}, nil
+}
+
+// Cursor is position in SortedList.
+// Offset is number of elements equal to Value by cmp which are before the position,
+// so pages do not skip or repeat elements with equal keys.
+// Cursor with zero Offset is just before the first element which is not less than Value.
+type Cursor[T comparable] struct {
+	Value  T   `json:"value"`
+	Offset int `json:"offset"`
+}
+
+// CursorPage is a page of SortedList located by cursor.
+type CursorPage[T comparable] struct {
+	Items   []T  `json:"items"`
+	HasNext bool `json:"has_next"`
+	HasPrev bool `json:"has_prev"`
+	// Next is cursor of next page. It is nil if there is no next page.
+	Next *Cursor[T] `json:"next,omitempty"`
+	// Prev is cursor of previous page. It is nil if there is no previous page.
+	Prev *Cursor[T] `json:"prev,omitempty"`
+}
+
+// After returns up to size elements from cursor.
+// Without cursor, it returns first page.
+// Pages stay stable when elements are inserted before cursor between requests.
+func (s *SortedList[T]) After(size int, cursor ...Cursor[T]) *CursorPage[T] {
+	start := 0
+	if len(cursor) > 0 {
+		start = s.position(cursor[0])
+	}
+
+	size = max(0, min(size, len(s.items())-start))
+
+	return s.cursorPage(start, start+size)
+}
+
+// Before returns up to size elements before cursor.
+// Without cursor, it returns last page.
+// Pages stay stable when elements are inserted after cursor between requests.
+func (s *SortedList[T]) Before(size int, cursor ...Cursor[T]) *CursorPage[T] {
+	end := len(s.items())
+	if len(cursor) > 0 {
+		end = s.position(cursor[0])
+	}
+
+	if size < 0 {
+		size = 0
+	}
+
+	return s.cursorPage(end-size, end)
+}
+
+// position returns index of element just after cursor.
+func (s *SortedList[T]) position(c Cursor[T]) int {
+	lo, hi := s.LowerBound(c.Value), s.UpperBound(c.Value)
+	return lo + max(0, min(c.Offset, hi-lo))
+}
+
+// cursorAt returns cursor just before element at index i.
+// It is located by the element before it if any.
+func (s *SortedList[T]) cursorAt(i int) *Cursor[T] {
+	v := s.items()[max(i-1, 0)]
+	return &Cursor[T]{Value: v, Offset: i - s.LowerBound(v)}
+}
+
+func (s *SortedList[T]) cursorPage(start, end int) *CursorPage[T] {
+	all := s.items()
+	if start < 0 {
+		start = 0
+	}
+	if end > len(all) {
+		end = len(all)
+	}
+	items := make([]T, end-start)
+	copy(items, all[start:end])
+
+	p := &CursorPage[T]{
+		Items:   items,
+		HasNext: end < len(all),
+		HasPrev: start > 0,
+	}
+	if p.HasNext {
+		p.Next = s.cursorAt(end)
+	}
+	if p.HasPrev {
+		p.Prev = s.cursorAt(start)
+	}
+
+	return p
+}
@@ -0,0 +1,348 @@
+package linq
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestList_Paginate(t *testing.T) {
+	type args struct {
+		page int
+		size int
+	}
+	tests := []struct {
+		name    string
+		fields  []T
+		args    args
+		want    *Page[T]
+		wantErr bool
+	}{
+		{
+			name:   "first page",
+			fields: []T{1, 2, 3, 4, 5},
+			args: args{
+				page: 1,
+				size: 2,
+			},
+			want: &Page[T]{
+				Items:      []T{1, 2},
+				Page:       1,
+				Size:       2,
+				TotalCount: 5,
+				TotalPages: 3,
+				HasNext:    true,
+				HasPrev:    false,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "last page",
+			fields: []T{1, 2, 3, 4, 5},
+			args: args{
+				page: 3,
+				size: 2,
+			},
+			want: &Page[T]{
+				Items:      []T{5},
+				Page:       3,
+				Size:       2,
+				TotalCount: 5,
+				TotalPages: 3,
+				HasNext:    false,
+				HasPrev:    true,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "page exceeded the maximum",
+			fields: []T{1, 2, 3, 4, 5},
+			args: args{
+				page: 4,
+				size: 2,
+			},
+			want: &Page[T]{
+				Items:      []T{},
+				Page:       4,
+				Size:       2,
+				TotalCount: 5,
+				TotalPages: 3,
+				HasNext:    false,
+				HasPrev:    true,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "empty list",
+			fields: []T{},
+			args: args{
+				page: 1,
+				size: 2,
+			},
+			want: &Page[T]{
+				Items:      []T{},
+				Page:       1,
+				Size:       2,
+				TotalCount: 0,
+				TotalPages: 0,
+				HasNext:    false,
+				HasPrev:    false,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "page is lower than 1",
+			fields: []T{1, 2, 3},
+			args: args{
+				page: 0,
+				size: 2,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "size is lower than 1",
+			fields: []T{1, 2, 3},
+			args: args{
+				page: 1,
+				size: 0,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := From(tt.fields).Paginate(tt.args.page, tt.args.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Paginate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Paginate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_JSON(t *testing.T) {
+	p, _ := From([]T{1, 2, 3}).Paginate(1, 2)
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Errorf("Marshal() error = %v", err)
+	}
+	want := `{"items":[1,2],"page":1,"size":2,"total_count":3,"total_pages":2,"has_next":true,"has_prev":false}`
+	if string(b) != want {
+		t.Errorf("Marshal() = %s, want %s", b, want)
+	}
+
+	var got Page[T]
+	if err := json.Unmarshal(b, &got); err != nil || !reflect.DeepEqual(&got, p) {
+		t.Errorf("Unmarshal() = %v, %v, want %v", got, err, p)
+	}
+}
+
+func TestSortedList_After(t *testing.T) {
+	s := From([]T{10, 20, 30, 40, 50}).AsSorted(compareT)
+	type args struct {
+		size   int
+		cursor []Cursor[T]
+	}
+	tests := []struct {
+		name string
+		args args
+		want *CursorPage[T]
+	}{
+		{
+			name: "first page",
+			args: args{
+				size:   2,
+				cursor: nil,
+			},
+			want: &CursorPage[T]{
+				Items:   []T{10, 20},
+				HasNext: true,
+				HasPrev: false,
+				Next:    &Cursor[T]{Value: 20, Offset: 1},
+			},
+		},
+		{
+			name: "after cursor",
+			args: args{
+				size:   2,
+				cursor: []Cursor[T]{{Value: 20, Offset: 1}},
+			},
+			want: &CursorPage[T]{
+				Items:   []T{30, 40},
+				HasNext: true,
+				HasPrev: true,
+				Next:    &Cursor[T]{Value: 40, Offset: 1},
+				Prev:    &Cursor[T]{Value: 20, Offset: 1},
+			},
+		},
+		{
+			name: "after missing cursor",
+			args: args{
+				size:   5,
+				cursor: []Cursor[T]{{Value: 35}},
+			},
+			want: &CursorPage[T]{
+				Items:   []T{40, 50},
+				HasNext: false,
+				HasPrev: true,
+				Prev:    &Cursor[T]{Value: 30, Offset: 1},
+			},
+		},
+		{
+			name: "largest size after cursor",
+			args: args{
+				size:   math.MaxInt,
+				cursor: []Cursor[T]{{Value: 10, Offset: 1}},
+			},
+			want: &CursorPage[T]{
+				Items:   []T{20, 30, 40, 50},
+				HasNext: false,
+				HasPrev: true,
+				Prev:    &Cursor[T]{Value: 10, Offset: 1},
+			},
+		},
+		{
+			name: "largest offset",
+			args: args{
+				size:   1,
+				cursor: []Cursor[T]{{Value: 30, Offset: math.MaxInt}},
+			},
+			want: &CursorPage[T]{
+				Items:   []T{40},
+				HasNext: true,
+				HasPrev: true,
+				Next:    &Cursor[T]{Value: 40, Offset: 1},
+				Prev:    &Cursor[T]{Value: 30, Offset: 1},
+			},
+		},
+		{
+			name: "after last element",
+			args: args{
+				size:   2,
+				cursor: []Cursor[T]{{Value: 50, Offset: 1}},
+			},
+			want: &CursorPage[T]{
+				Items:   []T{},
+				HasNext: false,
+				HasPrev: true,
+				Prev:    &Cursor[T]{Value: 50, Offset: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.After(tt.args.size, tt.args.cursor...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("After() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedList_Before(t *testing.T) {
+	s := From([]T{10, 20, 30, 40, 50}).AsSorted(compareT)
+	type args struct {
+		size   int
+		cursor []Cursor[T]
+	}
+	tests := []struct {
+		name string
+		args args
+		want *CursorPage[T]
+	}{
+		{
+			name: "last page",
+			args: args{
+				size:   2,
+				cursor: nil,
+			},
+			want: &CursorPage[T]{
+				Items:   []T{40, 50},
+				HasNext: false,
+				HasPrev: true,
+				Prev:    &Cursor[T]{Value: 30, Offset: 1},
+			},
+		},
+		{
+			name: "before cursor",
+			args: args{
+				size:   2,
+				cursor: []Cursor[T]{{Value: 40}},
+			},
+			want: &CursorPage[T]{
+				Items:   []T{20, 30},
+				HasNext: true,
+				HasPrev: true,
+				Next:    &Cursor[T]{Value: 30, Offset: 1},
+				Prev:    &Cursor[T]{Value: 10, Offset: 1},
+			},
+		},
+		{
+			name: "before second element",
+			args: args{
+				size:   2,
+				cursor: []Cursor[T]{{Value: 20}},
+			},
+			want: &CursorPage[T]{
+				Items:   []T{10},
+				HasNext: true,
+				HasPrev: false,
+				Next:    &Cursor[T]{Value: 10, Offset: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Before(tt.args.size, tt.args.cursor...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Before() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedList_After_Duplicates(t *testing.T) {
+	s := From([]T{1, 2, 2, 2, 3}).AsSorted(compareT)
+
+	forward := make([]T, 0)
+	for p := s.After(2); ; p = s.After(2, *p.Next) {
+		forward = append(forward, p.Items...)
+		if !p.HasNext {
+			break
+		}
+	}
+	if want := s.ToSlice(); !reflect.DeepEqual(forward, want) {
+		t.Errorf("After() pages = %v, want %v", forward, want)
+	}
+
+	backward := make([]T, 0)
+	for p := s.Before(2); ; p = s.Before(2, *p.Prev) {
+		backward = append(p.Items, backward...)
+		if !p.HasPrev {
+			break
+		}
+	}
+	if want := s.ToSlice(); !reflect.DeepEqual(backward, want) {
+		t.Errorf("Before() pages = %v, want %v", backward, want)
+	}
+
+	if got := s.After(2, Cursor[T]{Value: 2, Offset: 2}).Items; !reflect.DeepEqual(got, []T{2, 3}) {
+		t.Errorf("After() = %v, want %v", got, []T{2, 3})
+	}
+}
+
+func TestSortedList_After_Insertion(t *testing.T) {
+	first := From([]T{10, 20, 30, 40}).AsSorted(compareT).After(2)
+
+	b := From([]T{10, 20, 30, 40}).ToBuilder()
+	b.AddRange(5, 15)
+	second := b.Build().OrderBy(compareT).After(2, *first.Next)
+
+	if want := []T{30, 40}; !reflect.DeepEqual(second.Items, want) {
+		t.Errorf("After() = %v, want %v", second.Items, want)
+	}
+}
@@ -0,0 +1,110 @@
+package linq
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// Shuffle returns elements in random order.
+// Elements are shuffled by src, so the same source gives the same order.
+func (l *List[T]) Shuffle(src rand.Source) *List[T] {
+	sp := l.span("Shuffle")
+	s := make([]T, len(l.items()))
+	copy(s, l.items())
+	r := rand.New(src)
+	r.Shuffle(len(s), func(i, j int) {
+		s[i], s[j] = s[j], s[i]
+	})
+
+	return sp.end(s)
+}
+
+// Sample returns n elements chosen at random without replacement, in random order.
+// If n is greater than length of list, then it returns all elements.
+func (l *List[T]) Sample(n int, src rand.Source) *List[T] {
+	sp := l.span("Sample")
+	s := make([]T, len(l.items()))
+	copy(s, l.items())
+	n = max(0, min(n, len(s)))
+	r := rand.New(src)
+	for i := 0; i < n; i++ {
+		j := i + r.IntN(len(s)-i)
+		s[i], s[j] = s[j], s[i]
+	}
+
+	return sp.end(s[:n:n])
+}
+
+// Sample returns n elements chosen at random without replacement.
+// Source is enumerated once by reservoir sampling, so only n elements are kept in memory.
+// If n is greater than number of elements, then it returns all elements.
+func (s *Sequence[T]) Sample(n int, src rand.Source) *List[T] {
+	reservoir := make([]T, 0, max(0, n))
+	if n <= 0 {
+		return From(reservoir)
+	}
+	r := rand.New(src)
+	i := 0
+	for t := range s.Seq() {
+		if i < n {
+			reservoir = append(reservoir, t)
+		} else if j := r.IntN(i + 1); j < n {
+			reservoir[j] = t
+		}
+		i++
+	}
+
+	return From(reservoir)
+}
+
+// SampleWeighted returns n elements chosen at random without replacement, with probability
+// proportional to weight, in order of selection.
+// Elements whose weight is not positive are never chosen.
+// If n is greater than number of such elements, then it returns all of them.
+func (l *List[T]) SampleWeighted(n int, weight func(value T) float64, src rand.Source) *List[T] {
+	type keyed struct {
+		key   float64
+		value T
+	}
+
+	sp := l.span("SampleWeighted")
+	r := rand.New(src)
+	s := make([]keyed, 0, len(l.items()))
+	for _, t := range l.items() {
+		w := weight(t)
+		if w <= 0 || math.IsNaN(w) {
+			continue
+		}
+		// Efraimidis-Spirakis: the n largest u^(1/w) are a weighted sample, compared in log scale.
+		s = append(s, keyed{key: math.Log(1-r.Float64()) / w, value: t})
+	}
+	slices.SortStableFunc(s, func(a, b keyed) int {
+		switch {
+		case a.key > b.key:
+			return -1
+		case a.key < b.key:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	n = max(0, min(n, len(s)))
+	result := make([]T, n)
+	for i := range result {
+		result[i] = s[i].value
+	}
+
+	return sp.end(result)
+}
+
+// RandomElement returns an element chosen at random.
+// If list is empty, then it returns error.
+func (l *List[T]) RandomElement(src rand.Source) (T, error) {
+	if len(l.items()) == 0 {
+		return *new(T), ErrEmpty
+	}
+
+	return l.items()[rand.New(src).IntN(len(l.items()))], nil
+}
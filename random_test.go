@@ -0,0 +1,131 @@
+package linq
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func newSource() rand.Source {
+	return rand.NewPCG(1, 2)
+}
+
+func TestList_Shuffle(t *testing.T) {
+	l := From([]T{1, 2, 3, 4, 5, 6, 7, 8})
+	got := l.Shuffle(newSource())
+	if !reflect.DeepEqual(got, l.Shuffle(newSource())) {
+		t.Errorf("Shuffle() is not reproducible with the same source")
+	}
+	if got.SequenceEqual(l) {
+		t.Errorf("Shuffle() = %v, want different order", got)
+	}
+	sorted := slices.Clone(got.ToSlice())
+	slices.Sort(sorted)
+	if !reflect.DeepEqual(sorted, l.ToSlice()) {
+		t.Errorf("Shuffle() = %v, want permutation of %v", got, l)
+	}
+	if !reflect.DeepEqual(l.ToSlice(), []T{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Shuffle() modified list: %v", l)
+	}
+	if got := (*List[T])(nil).Shuffle(newSource()); !reflect.DeepEqual(got, &List[T]{slice: []T{}}) {
+		t.Errorf("Shuffle() = %v, want empty list", got)
+	}
+}
+
+func TestList_Sample(t *testing.T) {
+	l := From([]T{1, 2, 3, 4, 5})
+	tests := []struct {
+		name    string
+		n       int
+		wantLen int
+	}{
+		{name: "sample some", n: 3, wantLen: 3},
+		{name: "sample more than length", n: 10, wantLen: 5},
+		{name: "sample none", n: 0, wantLen: 0},
+		{name: "negative n", n: -1, wantLen: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, got := range map[string]*List[T]{
+				"List":     l.Sample(tt.n, newSource()),
+				"Sequence": l.AsSequence().Sample(tt.n, newSource()),
+			} {
+				if got.Count() != tt.wantLen || got.Distinct().Count() != tt.wantLen {
+					t.Errorf("%v.Sample() = %v, want %v distinct elements", name, got, tt.wantLen)
+				}
+				if !got.All(func(v T, i int) bool { return l.Contains(v) }) {
+					t.Errorf("%v.Sample() = %v, want elements of %v", name, got, l)
+				}
+			}
+		})
+	}
+}
+
+func TestSequence_Sample_Uniform(t *testing.T) {
+	src := newSource()
+	counts := make(map[T]int)
+	seq := From([]T{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}).AsSequence()
+	for i := 0; i < 10000; i++ {
+		for _, v := range seq.Sample(2, src).ToSlice() {
+			counts[v]++
+		}
+	}
+	for v := T(0); v < 10; v++ {
+		if counts[v] < 1800 || counts[v] > 2200 {
+			t.Errorf("element %v sampled %v times, want about 2000", v, counts[v])
+		}
+	}
+}
+
+func TestList_SampleWeighted(t *testing.T) {
+	src := newSource()
+	l := From([]T{1, 2, 3, 0})
+	weight := func(v T) float64 { return float64(v) }
+	counts := make(map[T]int)
+	for i := 0; i < 6000; i++ {
+		got := l.SampleWeighted(1, weight, src)
+		counts[got.MustFirst()]++
+	}
+	if counts[0] != 0 {
+		t.Errorf("element of zero weight sampled %v times", counts[0])
+	}
+	for v := T(1); v <= 3; v++ {
+		if want := 1000 * int(v); counts[v] < want*9/10 || counts[v] > want*11/10 {
+			t.Errorf("element %v sampled %v times, want about %v", v, counts[v], want)
+		}
+	}
+
+	if got := l.SampleWeighted(10, weight, src); got.Count() != 3 || got.Contains(0) {
+		t.Errorf("SampleWeighted() = %v, want all elements of positive weight", got)
+	}
+	if !reflect.DeepEqual(l.SampleWeighted(2, weight, newSource()), l.SampleWeighted(2, weight, newSource())) {
+		t.Errorf("SampleWeighted() is not reproducible with the same source")
+	}
+}
+
+func TestList_Shuffle_Trace(t *testing.T) {
+	var b bytes.Buffer
+	l := From([]T{1, 2, 3, 4}).Trace(traceLogger(&b))
+
+	l.Shuffle(newSource()).Sample(3, newSource()).SampleWeighted(2, func(v T) float64 { return 1 }, newSource())
+	want := "level=DEBUG msg=linq op=Shuffle in=4 out=4\n" +
+		"level=DEBUG msg=linq op=Sample in=4 out=3\n" +
+		"level=DEBUG msg=linq op=SampleWeighted in=3 out=2\n"
+	if b.String() != want {
+		t.Errorf("Trace() logged %v, want %v", b.String(), want)
+	}
+}
+
+func TestList_RandomElement(t *testing.T) {
+	l := From([]T{1, 2, 3})
+	got, err := l.RandomElement(newSource())
+	if err != nil || !l.Contains(got) {
+		t.Errorf("RandomElement() = %v, %v", got, err)
+	}
+	if _, err := From([]T{}).RandomElement(newSource()); !errors.Is(err, ErrEmpty) {
+		t.Errorf("RandomElement() error = %v, want %v", err, ErrEmpty)
+	}
+}
@@ -0,0 +1,186 @@
+package linq
+
+import (
+	"iter"
+)
+
+// Sequence is lazy sequence of elements.
+// Elements are produced while Sequence is enumerated, and each enumeration runs its source again.
+// A nil *Sequence is valid empty sequence.
+type Sequence[T comparable] struct {
+	seq iter.Seq[T]
+}
+
+// FromSeq is constructor of Sequence.
+func FromSeq[T comparable](seq iter.Seq[T]) *Sequence[T] {
+	return &Sequence[T]{
+		seq: seq,
+	}
+}
+
+// AsSequence returns lazy sequence of elements.
+func (l *List[T]) AsSequence() *Sequence[T] {
+	s := l.items()
+	return FromSeq(func(yield func(T) bool) {
+		for _, t := range s {
+			if !yield(t) {
+				return
+			}
+		}
+	})
+}
+
+// Seq returns iterator over elements.
+func (s *Sequence[T]) Seq() iter.Seq[T] {
+	if s == nil || s.seq == nil {
+		return func(yield func(T) bool) {}
+	}
+	return s.seq
+}
+
+// Where returns condition matched elements
+func (s *Sequence[T]) Where(f func(value T, index int) bool) *Sequence[T] {
+	seq := s.Seq()
+	return FromSeq(func(yield func(T) bool) {
+		i := 0
+		for t := range seq {
+			if f(t, i) && !yield(t) {
+				return
+			}
+			i++
+		}
+	})
+}
+
+// Skip returns elements after the specified index.
+func (s *Sequence[T]) Skip(index int) *Sequence[T] {
+	return s.SkipWhile(func(value T, i int) bool { return i >= index })
+}
+
+// SkipWhile returns elements after the specified condition.
+func (s *Sequence[T]) SkipWhile(f func(value T, index int) bool) *Sequence[T] {
+	seq := s.Seq()
+	return FromSeq(func(yield func(T) bool) {
+		i, skipping := 0, true
+		for t := range seq {
+			if skipping && f(t, i) {
+				skipping = false
+			}
+			if !skipping && !yield(t) {
+				return
+			}
+			i++
+		}
+	})
+}
+
+// Take returns elements up to the specified index.
+// It stops enumerating source after count elements.
+func (s *Sequence[T]) Take(count int) *Sequence[T] {
+	seq := s.Seq()
+	return FromSeq(func(yield func(T) bool) {
+		if count <= 0 {
+			return
+		}
+		i := 0
+		for t := range seq {
+			if !yield(t) {
+				return
+			}
+			if i++; i >= count {
+				return
+			}
+		}
+	})
+}
+
+// TakeWhile returns elements up to the specified condition.
+func (s *Sequence[T]) TakeWhile(f func(value T, index int) bool) *Sequence[T] {
+	seq := s.Seq()
+	return FromSeq(func(yield func(T) bool) {
+		i := 0
+		for t := range seq {
+			if !f(t, i) || !yield(t) {
+				return
+			}
+			i++
+		}
+	})
+}
+
+// First gets first element of Sequence.
+// If element is not found, then it returns error.
+func (s *Sequence[T]) First(filter ...func(value T, index int) bool) (T, error) {
+	i := 0
+	for t := range s.Seq() {
+		if len(filter) == 0 || filter[0](t, i) {
+			return t, nil
+		}
+		i++
+	}
+	if i == 0 {
+		return *new(T), ErrEmpty
+	}
+
+	return *new(T), ErrNotFound
+}
+
+// All returns true if all elements are matched
+func (s *Sequence[T]) All(f func(value T, index int) bool) bool {
+	i := 0
+	for t := range s.Seq() {
+		if !f(t, i) {
+			return false
+		}
+		i++
+	}
+
+	return true
+}
+
+// Any returns true if there is matched element
+func (s *Sequence[T]) Any(f ...func(value T, index int) bool) bool {
+	_, err := s.First(f...)
+	return err == nil
+}
+
+// Contains returns true if there is matched element.
+// Elements are compared by eq, Equal method of T or == in this order.
+func (s *Sequence[T]) Contains(value T, eq ...Equaler[T]) bool {
+	e := equalerOf(eq)
+	for t := range s.Seq() {
+		if e == nil && t == value || e != nil && e.Equal(t, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns number of element
+func (s *Sequence[T]) Count(f ...func(value T, index int) bool) int {
+	n, i := 0, 0
+	for t := range s.Seq() {
+		if len(f) == 0 || f[0](t, i) {
+			n++
+		}
+		i++
+	}
+
+	return n
+}
+
+// ToSlice returns slice of elements
+func (s *Sequence[T]) ToSlice() []T {
+	r := make([]T, 0)
+	for t := range s.Seq() {
+		r = append(r, t)
+	}
+
+	return r
+}
+
+// ToList returns list of elements
+func (s *Sequence[T]) ToList() *List[T] {
+	return From(s.ToSlice())
+}
@@ -0,0 +1,108 @@
+package linq
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSequence(t *testing.T) {
+	s := From([]T{1, 2, 3, 4, 5}).AsSequence()
+	tests := []struct {
+		name string
+		seq  *Sequence[T]
+		want []T
+	}{
+		{
+			name: "enumerate",
+			seq:  s,
+			want: []T{1, 2, 3, 4, 5},
+		},
+		{
+			name: "where",
+			seq:  s.Where(func(v T, i int) bool { return v%2 == 1 }),
+			want: []T{1, 3, 5},
+		},
+		{
+			name: "skip",
+			seq:  s.Skip(2),
+			want: []T{3, 4, 5},
+		},
+		{
+			name: "skip while",
+			seq:  s.SkipWhile(func(v T, i int) bool { return v > 3 }),
+			want: []T{4, 5},
+		},
+		{
+			name: "take",
+			seq:  s.Take(2),
+			want: []T{1, 2},
+		},
+		{
+			name: "take while",
+			seq:  s.TakeWhile(func(v T, i int) bool { return v < 4 }),
+			want: []T{1, 2, 3},
+		},
+		{
+			name: "nil sequence",
+			seq:  nil,
+			want: []T{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.seq.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.want)
+			}
+			if got := tt.seq.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice() second enumeration = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSequence_Terminal(t *testing.T) {
+	s := From([]T{1, 2, 3}).AsSequence()
+	if got, err := s.First(func(v T, i int) bool { return v > 1 }); got != 2 || err != nil {
+		t.Errorf("First() = %v, %v, want %v", got, err, 2)
+	}
+	if _, err := s.First(func(v T, i int) bool { return v > 3 }); !errors.Is(err, ErrNotFound) {
+		t.Errorf("First() error = %v, want %v", err, ErrNotFound)
+	}
+	if _, err := (*Sequence[T])(nil).First(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("First() error = %v, want %v", err, ErrEmpty)
+	}
+	if !s.All(func(v T, i int) bool { return v > 0 }) {
+		t.Errorf("All() = false, want true")
+	}
+	if !s.Any() || s.Any(func(v T, i int) bool { return v > 3 }) {
+		t.Errorf("Any() mismatch")
+	}
+	if !s.Contains(3) || s.Contains(4) {
+		t.Errorf("Contains() mismatch")
+	}
+	if got := s.Count(func(v T, i int) bool { return i > 0 }); got != 2 {
+		t.Errorf("Count() = %v, want %v", got, 2)
+	}
+	if got := s.ToList(); !reflect.DeepEqual(got, &List[T]{slice: []T{1, 2, 3}}) {
+		t.Errorf("ToList() = %v", got)
+	}
+}
+
+func TestSequence_StopsEarly(t *testing.T) {
+	n := 0
+	s := FromSeq(func(yield func(T) bool) {
+		for i := 0; ; i++ {
+			n++
+			if !yield(T(i)) {
+				return
+			}
+		}
+	})
+	if got := s.Where(func(v T, i int) bool { return v%2 == 0 }).Take(3).ToSlice(); !reflect.DeepEqual(got, []T{0, 2, 4}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+	if n != 5 {
+		t.Errorf("source produced %v elements, want %v", n, 5)
+	}
+}
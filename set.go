@@ -0,0 +1,125 @@
+package linq
+
+// Set is immutable collection of unique elements which keeps insertion order.
+type Set[T comparable] struct {
+	m     map[T]struct{}
+	slice []T
+}
+
+// NewSet is constructor of Set.
+// Duplicate values are ignored.
+func NewSet[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{
+		m:     make(map[T]struct{}, len(values)),
+		slice: make([]T, 0, len(values)),
+	}
+	for _, v := range values {
+		if _, ok := s.m[v]; !ok {
+			s.m[v] = struct{}{}
+			s.slice = append(s.slice, v)
+		}
+	}
+
+	return s
+}
+
+// ToSet returns set of elements
+func (l *List[T]) ToSet() *Set[T] {
+	return NewSet(l.items()...)
+}
+
+// items returns elements of set. Nil set is empty.
+func (s *Set[T]) items() []T {
+	if s == nil {
+		return nil
+	}
+	return s.slice
+}
+
+// ToList returns list of elements in insertion order
+func (s *Set[T]) ToList() *List[T] {
+	items := s.items()
+	return From(items[:len(items):len(items)])
+}
+
+// First gets first element of Set.
+// If element is not found, then it returns error.
+func (s *Set[T]) First(filter ...func(value T, index int) bool) (T, error) {
+	return s.ToList().First(filter...)
+}
+
+// Where returns condition matched elements
+func (s *Set[T]) Where(f func(value T, index int) bool) *List[T] {
+	return s.ToList().Where(f)
+}
+
+// All returns true if all elements are matched
+func (s *Set[T]) All(f func(value T, index int) bool) bool {
+	return s.ToList().All(f)
+}
+
+// Any returns true if there is matched element
+func (s *Set[T]) Any(f ...func(value T, index int) bool) bool {
+	return s.ToList().Any(f...)
+}
+
+// Contains returns true if set has value.
+// Without eq, it looks up value by == unless T has Equal method.
+func (s *Set[T]) Contains(value T, eq ...Equaler[T]) bool {
+	if equalerOf(eq) != nil {
+		return s.ToList().Contains(value, eq...)
+	}
+
+	if s == nil {
+		return false
+	}
+	_, ok := s.m[value]
+	return ok
+}
+
+// Count returns number of element
+func (s *Set[T]) Count(f ...func(value T, index int) bool) int {
+	return s.ToList().Count(f...)
+}
+
+// Add returns set with values added
+func (s *Set[T]) Add(values ...T) *Set[T] {
+	items := s.items()
+	return NewSet(append(items[:len(items):len(items)], values...)...)
+}
+
+// Remove returns set with values removed
+func (s *Set[T]) Remove(values ...T) *Set[T] {
+	return s.Except(NewSet(values...))
+}
+
+// Union returns set of elements in either set
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	return s.Add(other.items()...)
+}
+
+// Intersect returns set of elements in both sets
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	return NewSet(s.Where(func(value T, index int) bool {
+		return other.Contains(value)
+	}).slice...)
+}
+
+// Except returns set of elements which are not in other
+func (s *Set[T]) Except(other *Set[T]) *Set[T] {
+	return NewSet(s.Where(func(value T, index int) bool {
+		return !other.Contains(value)
+	}).slice...)
+}
+
+// IsSubsetOf returns true if all elements are in other
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	return s.All(func(value T, index int) bool {
+		return other.Contains(value)
+	})
+}
+
+// IsSupersetOf returns true if all elements of other are in set
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
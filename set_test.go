@@ -0,0 +1,180 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+var (
+	_ Queryable[T]                   = (*List[T])(nil)
+	_ Queryable[T]                   = (*Set[T])(nil)
+	_ Queryable[KeyValue[string, T]] = (*Dictionary[string, T])(nil)
+	_ Queryable[KeyValue[string, T]] = (*Lookup[string, T])(nil)
+)
+
+func TestNewSet(t *testing.T) {
+	type args struct {
+		values []T
+	}
+	tests := []struct {
+		name string
+		args args
+		want []T
+	}{
+		{
+			name: "remove duplicate values",
+			args: args{
+				values: []T{3, 1, 3, 2, 1},
+			},
+			want: []T{3, 1, 2},
+		},
+		{
+			name: "empty",
+			args: args{
+				values: nil,
+			},
+			want: []T{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSet(tt.args.values...).ToList().ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_Query(t *testing.T) {
+	s := From([]T{1, 2, 2, 3, 4}).ToSet()
+	even := func(value T, index int) bool {
+		return value%2 == 0
+	}
+
+	if got := s.Count(); got != 4 {
+		t.Errorf("Count() = %v, want %v", got, 4)
+	}
+	if got := s.Where(even).ToSlice(); !reflect.DeepEqual(got, []T{2, 4}) {
+		t.Errorf("Where() = %v, want %v", got, []T{2, 4})
+	}
+	if got, err := s.First(even); err != nil || got != 2 {
+		t.Errorf("First() = %v, %v, want %v", got, err, 2)
+	}
+	if !s.Any(even) || s.All(even) {
+		t.Errorf("Any() or All() returned unexpected result")
+	}
+	if !s.Contains(3) || s.Contains(5) {
+		t.Errorf("Contains() returned unexpected result")
+	}
+}
+
+func TestSet_Add(t *testing.T) {
+	s := NewSet[T](1, 2)
+	got := s.Add(2, 3)
+	if want := []T{1, 2, 3}; !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("Add() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+	if want := []T{1, 2}; !reflect.DeepEqual(s.ToList().ToSlice(), want) {
+		t.Errorf("Add() changed original set: %v, want %v", s.ToList().ToSlice(), want)
+	}
+}
+
+func TestSet_Remove(t *testing.T) {
+	s := NewSet[T](1, 2, 3)
+	got := s.Remove(2, 4)
+	if want := []T{1, 3}; !reflect.DeepEqual(got.ToList().ToSlice(), want) {
+		t.Errorf("Remove() = %v, want %v", got.ToList().ToSlice(), want)
+	}
+	if !s.Contains(2) {
+		t.Errorf("Remove() changed original set")
+	}
+}
+
+func TestSet_Operations(t *testing.T) {
+	a := NewSet[T](1, 2, 3)
+	b := NewSet[T](2, 3, 4)
+	tests := []struct {
+		name string
+		got  *Set[T]
+		want []T
+	}{
+		{
+			name: "union",
+			got:  a.Union(b),
+			want: []T{1, 2, 3, 4},
+		},
+		{
+			name: "intersect",
+			got:  a.Intersect(b),
+			want: []T{2, 3},
+		},
+		{
+			name: "except",
+			got:  a.Except(b),
+			want: []T{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.ToList().ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%v = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_IsSubsetOf(t *testing.T) {
+	type args struct {
+		other *Set[T]
+	}
+	tests := []struct {
+		name   string
+		fields []T
+		args   args
+		want   bool
+	}{
+		{
+			name:   "subset",
+			fields: []T{1, 2},
+			args: args{
+				other: NewSet[T](1, 2, 3),
+			},
+			want: true,
+		},
+		{
+			name:   "same set",
+			fields: []T{1, 2},
+			args: args{
+				other: NewSet[T](2, 1),
+			},
+			want: true,
+		},
+		{
+			name:   "not subset",
+			fields: []T{1, 4},
+			args: args{
+				other: NewSet[T](1, 2, 3),
+			},
+			want: false,
+		},
+		{
+			name:   "empty set",
+			fields: []T{},
+			args: args{
+				other: NewSet[T](),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSet(tt.fields...)
+			if got := s.IsSubsetOf(tt.args.other); got != tt.want {
+				t.Errorf("IsSubsetOf() = %v, want %v", got, tt.want)
+			}
+			if got := tt.args.other.IsSupersetOf(s); got != tt.want {
+				t.Errorf("IsSupersetOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package linq
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SortedList is List whose elements are sorted in ascending order by cmp.
+// cmp returns negative number if a is less than b, positive number if a is greater than b and 0 otherwise.
+// Zero value is empty list, and so is nil for methods declared on SortedList.
+type SortedList[T comparable] struct {
+	*List[T]
+	cmp func(a, b T) int
+}
+
+// OrderBy returns list sorted by cmp.
+// Sort is stable, so equal elements keep their order.
+func (l *List[T]) OrderBy(cmp func(a, b T) int) *SortedList[T] {
+	sp := l.span("OrderBy")
+	s := make([]T, len(l.items()))
+	copy(s, l.items())
+	sort.SliceStable(s, func(i, j int) bool {
+		return cmp(s[i], s[j]) < 0
+	})
+
+	return &SortedList[T]{List: sp.end(s), cmp: cmp}
+}
+
+// Order returns list sorted by c or Compare method of T.
+// If c is not given and T has no Compare method, then it raises panic.
+func (l *List[T]) Order(c ...Comparer[T]) *SortedList[T] {
+	cmp := comparerOf(c)
+	if cmp == nil {
+		panic(fmt.Errorf("no comparer for %T", *new(T)))
+	}
+
+	return l.OrderBy(cmp.Compare)
+}
+
+// AsSorted returns list as sorted by cmp without sorting.
+// Elements must be already sorted by cmp.
+func (l *List[T]) AsSorted(cmp func(a, b T) int) *SortedList[T] {
+	if l == nil {
+		l = Empty[T]()
+	}
+	return &SortedList[T]{List: l, cmp: cmp}
+}
+
+// items returns elements of list. Nil list is empty.
+func (s *SortedList[T]) items() []T {
+	if s == nil {
+		return nil
+	}
+	return s.List.items()
+}
+
+// compare returns cmp of list, or cmp of other if list is nil.
+func (s *SortedList[T]) compare(other ...*SortedList[T]) func(a, b T) int {
+	if s != nil && s.cmp != nil {
+		return s.cmp
+	}
+	for _, o := range other {
+		if o != nil {
+			return o.cmp
+		}
+	}
+	return nil
+}
+
+// LowerBound returns index of first element which is not less than value.
+// If there is no such element, then it returns length of list.
+func (s *SortedList[T]) LowerBound(value T) int {
+	items := s.items()
+	return sort.Search(len(items), func(i int) bool {
+		return s.cmp(items[i], value) >= 0
+	})
+}
+
+// UpperBound returns index of first element which is greater than value.
+// If there is no such element, then it returns length of list.
+func (s *SortedList[T]) UpperBound(value T) int {
+	items := s.items()
+	return sort.Search(len(items), func(i int) bool {
+		return s.cmp(items[i], value) > 0
+	})
+}
+
+// BinarySearch returns index of first element which is equal to value by cmp.
+// If element is not found, then it returns index where value would be inserted and false.
+func (s *SortedList[T]) BinarySearch(value T) (int, bool) {
+	i, items := s.LowerBound(value), s.items()
+	return i, i < len(items) && s.cmp(items[i], value) == 0
+}
+
+// Contains returns true if there is element equal to value.
+// It searches only elements equal to value by cmp, and compares them like List.Contains.
+func (s *SortedList[T]) Contains(value T, eq ...Equaler[T]) bool {
+	i := s.LowerBound(value)
+	return From(s.items()[i:s.UpperBound(value)]).Contains(value, eq...)
+}
+
+// Range returns elements which are not less than lo and less than hi
+func (s *SortedList[T]) Range(lo, hi T) *SortedList[T] {
+	i := s.LowerBound(lo)
+	j := s.LowerBound(hi)
+	if j < i {
+		j = i
+	}
+
+	return &SortedList[T]{List: From(s.items()[i:j]), cmp: s.compare()}
+}
+
+// Union returns sorted elements in either list.
+// Elements equal by cmp are merged, so each of them appears as many times as in the list it appears most.
+func (s *SortedList[T]) Union(other *SortedList[T]) *SortedList[T] {
+	a, b := s.items(), other.items()
+	cmp := s.compare(other)
+	r := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			r = append(r, a[i])
+			i++
+		case c > 0:
+			r = append(r, b[j])
+			j++
+		default:
+			r = append(r, a[i])
+			i++
+			j++
+		}
+	}
+	r = append(r, a[i:]...)
+	r = append(r, b[j:]...)
+
+	return &SortedList[T]{List: From(r), cmp: cmp}
+}
+
+// Intersect returns sorted elements in both lists.
+// Elements equal by cmp are taken from this list.
+func (s *SortedList[T]) Intersect(other *SortedList[T]) *SortedList[T] {
+	a, b := s.items(), other.items()
+	cmp := s.compare(other)
+	r := make([]T, 0)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			r = append(r, a[i])
+			i++
+			j++
+		}
+	}
+
+	return &SortedList[T]{List: From(r), cmp: cmp}
+}
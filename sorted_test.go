@@ -0,0 +1,238 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func compareT(a, b T) int {
+	return int(a - b)
+}
+
+func TestList_OrderBy(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+	l := From([]pair{{3, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	got := l.OrderBy(func(a, b pair) int {
+		return a.key - b.key
+	})
+
+	want := []pair{{1, "b"}, {1, "d"}, {2, "c"}, {3, "a"}}
+	if !reflect.DeepEqual(got.ToSlice(), want) {
+		t.Errorf("OrderBy() = %v, want %v", got.ToSlice(), want)
+	}
+	if want := []pair{{3, "a"}, {1, "b"}, {2, "c"}, {1, "d"}}; !reflect.DeepEqual(l.ToSlice(), want) {
+		t.Errorf("OrderBy() changed source = %v, want %v", l.ToSlice(), want)
+	}
+}
+
+func TestSortedList_BinarySearch(t *testing.T) {
+	s := From([]T{1, 3, 3, 3, 5}).AsSorted(compareT)
+	type args struct {
+		value T
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      int
+		wantFound bool
+		wantLower int
+		wantUpper int
+	}{
+		{
+			name: "found",
+			args: args{
+				value: 3,
+			},
+			want:      1,
+			wantFound: true,
+			wantLower: 1,
+			wantUpper: 4,
+		},
+		{
+			name: "not found in middle",
+			args: args{
+				value: 4,
+			},
+			want:      4,
+			wantFound: false,
+			wantLower: 4,
+			wantUpper: 4,
+		},
+		{
+			name: "less than all",
+			args: args{
+				value: 0,
+			},
+			want:      0,
+			wantFound: false,
+			wantLower: 0,
+			wantUpper: 0,
+		},
+		{
+			name: "greater than all",
+			args: args{
+				value: 9,
+			},
+			want:      5,
+			wantFound: false,
+			wantLower: 5,
+			wantUpper: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := s.BinarySearch(tt.args.value)
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("BinarySearch() = %v, %v, want %v, %v", got, found, tt.want, tt.wantFound)
+			}
+			if got := s.LowerBound(tt.args.value); got != tt.wantLower {
+				t.Errorf("LowerBound() = %v, want %v", got, tt.wantLower)
+			}
+			if got := s.UpperBound(tt.args.value); got != tt.wantUpper {
+				t.Errorf("UpperBound() = %v, want %v", got, tt.wantUpper)
+			}
+			if got := s.Contains(tt.args.value); got != tt.wantFound {
+				t.Errorf("Contains() = %v, want %v", got, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestSortedList_Contains(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+	s := From([]pair{{1, "a"}, {2, "b"}, {2, "c"}}).OrderBy(func(a, b pair) int {
+		return a.key - b.key
+	})
+
+	if !s.Contains(pair{2, "c"}) {
+		t.Errorf("Contains() = false, want true")
+	}
+	if s.Contains(pair{2, "d"}) {
+		t.Errorf("Contains() = true, want false")
+	}
+}
+
+func TestSortedList_Range(t *testing.T) {
+	s := From([]T{1, 2, 3, 4, 5}).AsSorted(compareT)
+	type args struct {
+		lo T
+		hi T
+	}
+	tests := []struct {
+		name string
+		args args
+		want []T
+	}{
+		{
+			name: "middle range",
+			args: args{
+				lo: 2,
+				hi: 4,
+			},
+			want: []T{2, 3},
+		},
+		{
+			name: "whole range",
+			args: args{
+				lo: 0,
+				hi: 10,
+			},
+			want: []T{1, 2, 3, 4, 5},
+		},
+		{
+			name: "empty range",
+			args: args{
+				lo: 4,
+				hi: 2,
+			},
+			want: []T{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Range(tt.args.lo, tt.args.hi).ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Range() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedList_Union(t *testing.T) {
+	type args struct {
+		other []T
+	}
+	tests := []struct {
+		name   string
+		fields []T
+		args   args
+		want   []T
+	}{
+		{
+			name:   "merge lists",
+			fields: []T{1, 3, 5, 5},
+			args: args{
+				other: []T{2, 3, 5, 6},
+			},
+			want: []T{1, 2, 3, 5, 5, 6},
+		},
+		{
+			name:   "empty other",
+			fields: []T{1, 2},
+			args: args{
+				other: []T{},
+			},
+			want: []T{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := From(tt.fields).AsSorted(compareT)
+			if got := s.Union(From(tt.args.other).AsSorted(compareT)).ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedList_Intersect(t *testing.T) {
+	type args struct {
+		other []T
+	}
+	tests := []struct {
+		name   string
+		fields []T
+		args   args
+		want   []T
+	}{
+		{
+			name:   "common elements",
+			fields: []T{1, 3, 5, 5},
+			args: args{
+				other: []T{2, 3, 5, 6},
+			},
+			want: []T{3, 5},
+		},
+		{
+			name:   "no common elements",
+			fields: []T{1, 2},
+			args: args{
+				other: []T{3},
+			},
+			want: []T{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := From(tt.fields).AsSorted(compareT)
+			if got := s.Intersect(From(tt.args.other).AsSorted(compareT)).ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package linq
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// FromRows reads rows into List by scan.
+// Rows are always closed when FromRows returns.
+// If scan fails or rows.Err reports error, then it returns error.
+func FromRows[T comparable](rows *sql.Rows, scan func(rows *sql.Rows) (T, error)) (*List[T], error) {
+	seq, errf := FromRowsSeq(rows, scan)
+	l := seq.ToList()
+	if err := errf(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// FromRowsSeq returns lazy sequence of rows read by scan.
+// Rows are read while the sequence is enumerated, so it can be enumerated only once, and
+// they are closed when enumeration ends, including when First or Take stop early.
+// The returned function reports error of scan or rows.Err after enumeration.
+func FromRowsSeq[T comparable](rows *sql.Rows, scan func(rows *sql.Rows) (T, error)) (*Sequence[T], func() error) {
+	var err error
+	seq := FromSeq(func(yield func(T) bool) {
+		defer rows.Close()
+		for rows.Next() {
+			t, e := scan(rows)
+			if e != nil {
+				err = e
+				return
+			}
+			if !yield(t) {
+				return
+			}
+		}
+		err = rows.Err()
+	})
+
+	return seq, func() error { return err }
+}
+
+// scanPlans caches field indexes of struct by type and columns.
+var scanPlans sync.Map
+
+type scanPlanKey struct {
+	typ     reflect.Type
+	columns string
+}
+
+// ScanStruct scans current row into struct.
+// Columns are mapped to fields by `db:"name"` tag or field name, and unknown columns are ignored.
+// It can be passed to FromRows.
+// If T is not struct, then it returns error.
+func ScanStruct[T comparable](rows *sql.Rows) (T, error) {
+	var t T
+	columns, err := rows.Columns()
+	if err != nil {
+		return t, err
+	}
+
+	plan, err := scanPlan(reflect.TypeOf((*T)(nil)).Elem(), columns)
+	if err != nil {
+		return t, err
+	}
+
+	rv := reflect.ValueOf(&t).Elem()
+	dest := make([]any, len(columns))
+	for i, field := range plan {
+		if field == nil {
+			dest[i] = new(any)
+			continue
+		}
+		dest[i] = rv.FieldByIndex(field.index).Addr().Interface()
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return *new(T), err
+	}
+
+	return t, nil
+}
+
+func scanPlan(t reflect.Type, columns []string) ([]*taggedField, error) {
+	key := scanPlanKey{typ: t, columns: strings.Join(columns, "\x00")}
+	if plan, ok := scanPlans.Load(key); ok {
+		return plan.([]*taggedField), nil
+	}
+
+	fields, err := taggedFields(t, "db")
+	if err != nil {
+		return nil, fmt.Errorf("can not scan into %v: %w", t, err)
+	}
+	plan := make([]*taggedField, len(columns))
+	for i, c := range columns {
+		plan[i] = findField(fields, c)
+	}
+	scanPlans.Store(key, plan)
+
+	return plan, nil
+}
@@ -0,0 +1,320 @@
+package linq
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// fakeConnector serves the same result set for every query.
+type fakeConnector struct {
+	columns []string
+	rows    [][]driver.Value
+	err     error
+	closed  bool
+	// read is number of rows read by driver.
+	read int
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{c: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+type fakeConn struct {
+	c *fakeConnector
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return &fakeStmt{c: c.c}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+type fakeStmt struct {
+	c *fakeConnector
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.c.closed = false
+	s.c.read = 0
+	return &fakeRows{c: s.c}, nil
+}
+
+type fakeRows struct {
+	c     *fakeConnector
+	index int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.c.columns
+}
+
+func (r *fakeRows) Close() error {
+	r.c.closed = true
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.c.rows) {
+		if r.c.err != nil {
+			return r.c.err
+		}
+		return io.EOF
+	}
+	copy(dest, r.c.rows[r.index])
+	r.index++
+	r.c.read++
+	return nil
+}
+
+func query(t *testing.T, c *fakeConnector) *sql.Rows {
+	db := sql.OpenDB(c)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+type Person struct {
+	ID   int64  `db:"id"`
+	Name string `db:"name"`
+	Note *string
+}
+
+func TestFromRows(t *testing.T) {
+	errRows := errors.New("connection lost")
+	errScan := errors.New("scan failed")
+	type args struct {
+		connector *fakeConnector
+		scan      func(rows *sql.Rows) (int64, error)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *List[int64]
+		wantErr error
+	}{
+		{
+			name: "read rows",
+			args: args{
+				connector: &fakeConnector{
+					columns: []string{"id"},
+					rows:    [][]driver.Value{{int64(1)}, {int64(2)}, {int64(3)}},
+				},
+				scan: func(rows *sql.Rows) (int64, error) {
+					var id int64
+					err := rows.Scan(&id)
+					return id, err
+				},
+			},
+			want: &List[int64]{
+				slice: []int64{1, 2, 3},
+			},
+		},
+		{
+			name: "no rows",
+			args: args{
+				connector: &fakeConnector{
+					columns: []string{"id"},
+				},
+				scan: func(rows *sql.Rows) (int64, error) {
+					return 0, nil
+				},
+			},
+			want: &List[int64]{
+				slice: []int64{},
+			},
+		},
+		{
+			name: "rows error",
+			args: args{
+				connector: &fakeConnector{
+					columns: []string{"id"},
+					rows:    [][]driver.Value{{int64(1)}},
+					err:     errRows,
+				},
+				scan: func(rows *sql.Rows) (int64, error) {
+					var id int64
+					err := rows.Scan(&id)
+					return id, err
+				},
+			},
+			want:    nil,
+			wantErr: errRows,
+		},
+		{
+			name: "scan error",
+			args: args{
+				connector: &fakeConnector{
+					columns: []string{"id"},
+					rows:    [][]driver.Value{{int64(1)}, {int64(2)}},
+				},
+				scan: func(rows *sql.Rows) (int64, error) {
+					return 0, errScan
+				},
+			},
+			want:    nil,
+			wantErr: errScan,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromRows(query(t, tt.args.connector), tt.args.scan)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromRows() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromRows() = %v, want %v", got, tt.want)
+			}
+			if !tt.args.connector.closed {
+				t.Errorf("FromRows() did not close rows")
+			}
+		})
+	}
+}
+
+func TestFromRowsSeq(t *testing.T) {
+	c := &fakeConnector{
+		columns: []string{"id"},
+		rows:    [][]driver.Value{{int64(1)}, {int64(2)}, {int64(3)}},
+	}
+	scan := func(rows *sql.Rows) (int64, error) {
+		var id int64
+		err := rows.Scan(&id)
+		return id, err
+	}
+
+	seq, errf := FromRowsSeq(query(t, c), scan)
+	if got := seq.Take(1).ToSlice(); !reflect.DeepEqual(got, []int64{1}) {
+		t.Errorf("Take() = %v, want %v", got, []int64{1})
+	}
+	if err := errf(); err != nil {
+		t.Errorf("FromRowsSeq() error = %v", err)
+	}
+	if !c.closed {
+		t.Errorf("FromRowsSeq() did not close rows after Take()")
+	}
+	if c.read != 1 {
+		t.Errorf("FromRowsSeq() read %v rows, want %v", c.read, 1)
+	}
+}
+
+func TestFromRowsSeq_Error(t *testing.T) {
+	errRows := errors.New("connection lost")
+	c := &fakeConnector{
+		columns: []string{"id"},
+		rows:    [][]driver.Value{{int64(1)}},
+		err:     errRows,
+	}
+	seq, errf := FromRowsSeq(query(t, c), func(rows *sql.Rows) (int64, error) {
+		var id int64
+		err := rows.Scan(&id)
+		return id, err
+	})
+	if got := seq.Count(); got != 1 {
+		t.Errorf("Count() = %v, want %v", got, 1)
+	}
+	if err := errf(); !errors.Is(err, errRows) {
+		t.Errorf("FromRowsSeq() error = %v, want %v", err, errRows)
+	}
+	if !c.closed {
+		t.Errorf("FromRowsSeq() did not close rows")
+	}
+}
+
+func TestScanStruct(t *testing.T) {
+	note := "hello"
+	type args struct {
+		connector *fakeConnector
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []Person
+		wantErr bool
+	}{
+		{
+			name: "map columns to fields",
+			args: args{
+				connector: &fakeConnector{
+					columns: []string{"name", "id", "note", "extra"},
+					rows: [][]driver.Value{
+						{"alice", int64(1), nil, "x"},
+						{"bob", int64(2), "hello", "y"},
+					},
+				},
+			},
+			want: []Person{
+				{ID: 1, Name: "alice"},
+				{ID: 2, Name: "bob", Note: &note},
+			},
+			wantErr: false,
+		},
+		{
+			name: "value can not be converted",
+			args: args{
+				connector: &fakeConnector{
+					columns: []string{"id"},
+					rows:    [][]driver.Value{{"x"}},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromRows(query(t, tt.args.connector), ScanStruct[Person])
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScanStruct() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.ToSlice(), tt.want) {
+				t.Errorf("ScanStruct() = %v, want %v", got.ToSlice(), tt.want)
+			}
+		})
+	}
+}
+
+func TestScanStruct_NotStruct(t *testing.T) {
+	rows := query(t, &fakeConnector{
+		columns: []string{"id"},
+		rows:    [][]driver.Value{{int64(1)}},
+	})
+	if _, err := FromRows(rows, ScanStruct[int64]); err == nil {
+		t.Errorf("ScanStruct() error = nil, want error")
+	}
+}
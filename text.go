@@ -0,0 +1,108 @@
+package linq
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// FromLines returns lazy sequence of lines read from r without line endings.
+// r is read while the sequence is enumerated, so it can be enumerated only once.
+// The returned function reports read error after enumeration.
+func FromLines(r io.Reader) (*Sequence[string], func() error) {
+	return scan(r, bufio.ScanLines)
+}
+
+// FromWords returns lazy sequence of space separated words read from r.
+// r is read while the sequence is enumerated, so it can be enumerated only once.
+// The returned function reports read error after enumeration.
+func FromWords(r io.Reader) (*Sequence[string], func() error) {
+	return scan(r, bufio.ScanWords)
+}
+
+func scan(r io.Reader, split bufio.SplitFunc) (*Sequence[string], func() error) {
+	sc := bufio.NewScanner(r)
+	sc.Split(split)
+	seq := FromSeq(func(yield func(string) bool) {
+		for sc.Scan() {
+			if !yield(sc.Text()) {
+				return
+			}
+		}
+	})
+
+	return seq, sc.Err
+}
+
+// JoinStrings returns elements joined by sep.
+// Elements other than string are formatted by fmt.
+func (l *List[T]) JoinStrings(sep string) string {
+	if s, ok := any(l.items()).([]string); ok {
+		return strings.Join(s, sep)
+	}
+
+	var b strings.Builder
+	joinStrings(&b, slices.Values(l.items()), sep)
+
+	return b.String()
+}
+
+// JoinStrings returns elements joined by sep.
+// Elements other than string are formatted by fmt.
+func (s *Sequence[T]) JoinStrings(sep string) string {
+	var b strings.Builder
+	joinStrings(&b, s.Seq(), sep)
+
+	return b.String()
+}
+
+func joinStrings[T comparable](b *strings.Builder, seq iter.Seq[T], sep string) {
+	first := true
+	for t := range seq {
+		if !first {
+			b.WriteString(sep)
+		}
+		first = false
+		if v, ok := any(t).(string); ok {
+			b.WriteString(v)
+		} else {
+			fmt.Fprint(b, t)
+		}
+	}
+}
+
+// ToWriter writes each element formatted by format as a line to w.
+// If format is nil, then elements are formatted by fmt.
+func (l *List[T]) ToWriter(w io.Writer, format func(value T) string) error {
+	return writeLines(w, slices.Values(l.items()), format)
+}
+
+// ToWriter writes each element formatted by format as a line to w while enumerating,
+// so elements are not buffered in memory.
+// If format is nil, then elements are formatted by fmt.
+func (s *Sequence[T]) ToWriter(w io.Writer, format func(value T) string) error {
+	return writeLines(w, s.Seq(), format)
+}
+
+func writeLines[T comparable](w io.Writer, seq iter.Seq[T], format func(value T) string) error {
+	bw := bufio.NewWriter(w)
+	for t := range seq {
+		var err error
+		if format != nil {
+			_, err = bw.WriteString(format(t))
+		} else {
+			_, err = fmt.Fprint(bw, t)
+		}
+		if err == nil {
+			err = bw.WriteByte('\n')
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,149 @@
+package linq
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFromLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "read lines",
+			input: "a b\r\n\nc\n",
+			want:  []string{"a b", "", "c"},
+		},
+		{
+			name:  "without trailing newline",
+			input: "a\nb",
+			want:  []string{"a", "b"},
+		},
+		{
+			name:  "empty input",
+			input: "",
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq, errf := FromLines(strings.NewReader(tt.input))
+			if got := seq.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromLines() = %v, want %v", got, tt.want)
+			}
+			if err := errf(); err != nil {
+				t.Errorf("FromLines() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestFromLines_Lazy(t *testing.T) {
+	r := strings.NewReader("a\nb\nc\n" + strings.Repeat("x\n", 10000))
+	seq, _ := FromLines(r)
+	if got, err := seq.First(func(value string, index int) bool { return value == "b" }); got != "b" || err != nil {
+		t.Errorf("First() = %v, %v, want %v", got, err, "b")
+	}
+	if r.Len() == 0 {
+		t.Errorf("FromLines() read whole input")
+	}
+}
+
+func TestFromLines_Error(t *testing.T) {
+	want := errors.New("read error")
+	seq, errf := FromLines(iotest.ErrReader(want))
+	if got := seq.Count(); got != 0 {
+		t.Errorf("Count() = %v, want 0", got)
+	}
+	if err := errf(); !errors.Is(err, want) {
+		t.Errorf("FromLines() error = %v, want %v", err, want)
+	}
+}
+
+func TestFromWords(t *testing.T) {
+	seq, errf := FromWords(strings.NewReader(" the quick\n\tbrown  fox "))
+	want := []string{"the", "quick", "brown", "fox"}
+	if got := seq.ToSlice(); !reflect.DeepEqual(got, want) || errf() != nil {
+		t.Errorf("FromWords() = %v, %v, want %v", got, errf(), want)
+	}
+}
+
+func TestList_JoinStrings(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "strings",
+			got:  From([]string{"a", "b", "c"}).JoinStrings(", "),
+			want: "a, b, c",
+		},
+		{
+			name: "empty list",
+			got:  From([]string{}).JoinStrings(", "),
+			want: "",
+		},
+		{
+			name: "nil list",
+			got:  (*List[T])(nil).JoinStrings(", "),
+			want: "",
+		},
+		{
+			name: "formatted elements",
+			got:  From([]T{1, 2, 3}).JoinStrings("-"),
+			want: "1-2-3",
+		},
+		{
+			name: "sequence",
+			got:  From([]T{1, 2, 3}).AsSequence().Where(func(v T, i int) bool { return v != 2 }).JoinStrings("-"),
+			want: "1-3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("JoinStrings() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_ToWriter(t *testing.T) {
+	var b bytes.Buffer
+	if err := From([]T{1, 2}).ToWriter(&b, func(value T) string { return fmt.Sprintf("n=%v", value) }); err != nil {
+		t.Errorf("ToWriter() error = %v", err)
+	}
+	if want := "n=1\nn=2\n"; b.String() != want {
+		t.Errorf("ToWriter() = %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	seq, _ := FromWords(strings.NewReader("a b"))
+	if err := seq.ToWriter(&b, nil); err != nil {
+		t.Errorf("ToWriter() error = %v", err)
+	}
+	if want := "a\nb\n"; b.String() != want {
+		t.Errorf("ToWriter() = %q, want %q", b.String(), want)
+	}
+}
+
+func TestList_ToWriter_Error(t *testing.T) {
+	l := From([]string{strings.Repeat("x", 8192)})
+	if err := l.ToWriter(errWriter{}, nil); err == nil {
+		t.Errorf("ToWriter() error = nil, want error")
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write error")
+}
@@ -0,0 +1,216 @@
+// Package timeseries buckets, resamples and smooths timestamped elements of linq lists.
+//
+// Buckets are aligned to wall clock of a location, so hourly and daily buckets start
+// on the hour and at midnight of that location even when its offset is not whole hours
+// or changes by daylight saving time. Location defaults to UTC.
+package timeseries
+
+import (
+	"slices"
+	"time"
+
+	"github.com/YusukeKishino/go-linq"
+)
+
+// Bucket is elements whose timestamps are in [Start, End).
+type Bucket[T comparable] struct {
+	Start time.Time
+	End   time.Time
+	Items *linq.List[T]
+}
+
+// Point is value at time.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Gap is interval between two consecutive timestamps which is longer than allowed.
+type Gap struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Fill is strategy to fill buckets which have no points.
+type Fill int
+
+const (
+	// FillZero fills empty bucket with 0.
+	FillZero Fill = iota
+	// FillPrevious fills empty bucket with value of previous bucket.
+	FillPrevious
+	// FillLinear fills empty bucket by linear interpolation between surrounding buckets.
+	FillLinear
+)
+
+// BucketByTime groups elements into buckets of width by timestamp.
+// Only buckets which have elements are returned, in chronological order, and elements
+// keep their order in each bucket.
+// If width is lower than or equal to 0, then it returns empty list.
+func BucketByTime[T comparable](l *linq.List[T], ts func(value T) time.Time, width time.Duration, loc ...*time.Location) *linq.List[Bucket[T]] {
+	if width <= 0 {
+		return linq.From([]Bucket[T]{})
+	}
+	location := locationOf(loc)
+
+	items := make(map[time.Time][]T)
+	starts := make([]time.Time, 0)
+	for _, t := range l.ToSlice() {
+		wall := floor(ts(t), width, location)
+		if _, ok := items[wall]; !ok {
+			starts = append(starts, wall)
+		}
+		items[wall] = append(items[wall], t)
+	}
+	slices.SortFunc(starts, time.Time.Compare)
+
+	s := make([]Bucket[T], len(starts))
+	for i, wall := range starts {
+		s[i] = Bucket[T]{
+			Start: fromWall(wall, location),
+			End:   fromWall(wall.Add(width), location),
+			Items: linq.From(items[wall]),
+		}
+	}
+
+	return linq.From(s)
+}
+
+// Points returns points of elements sorted by time.
+func Points[T comparable](l *linq.List[T], ts func(value T) time.Time, value func(value T) float64) *linq.List[Point] {
+	s := make([]Point, 0, l.Count())
+	for _, t := range l.ToSlice() {
+		s = append(s, Point{Time: ts(t), Value: value(t)})
+	}
+	slices.SortStableFunc(s, func(a, b Point) int { return a.Time.Compare(b.Time) })
+
+	return linq.From(s)
+}
+
+// Resample returns one point per bucket of width from first to last point.
+// Value of each bucket is average of its points, and empty buckets are filled by fill.
+// Points are placed at start of buckets, and wall clock which does not exist in location
+// because of daylight saving time has no point.
+// If width is lower than or equal to 0, then it returns empty list.
+func Resample(points *linq.List[Point], width time.Duration, fill Fill, loc ...*time.Location) *linq.List[Point] {
+	buckets := BucketByTime(points, func(p Point) time.Time { return p.Time }, width, loc...).ToSlice()
+	if len(buckets) == 0 {
+		return linq.From([]Point{})
+	}
+	location := locationOf(loc)
+
+	s := make([]Point, 0, len(buckets))
+	last := floor(buckets[len(buckets)-1].Start, width, location)
+	for i, wall := 0, floor(buckets[0].Start, width, location); !wall.After(last); wall = wall.Add(width) {
+		start := fromWall(wall, location)
+		if !floor(start, width, location).Equal(wall) || len(s) > 0 && !start.After(s[len(s)-1].Time) {
+			// Wall clock skipped or repeated by daylight saving time has no bucket of its own.
+			continue
+		}
+		if floor(buckets[i].Start, width, location).Equal(wall) {
+			s = append(s, Point{Time: start, Value: buckets[i].Items.Average(func(p Point, _ int) float64 { return p.Value })})
+			i++
+			continue
+		}
+
+		p := Point{Time: start}
+		switch fill {
+		case FillPrevious:
+			p.Value = s[len(s)-1].Value
+		case FillLinear:
+			prev := s[len(s)-1]
+			next := Point{Time: buckets[i].Start, Value: buckets[i].Items.Average(func(p Point, _ int) float64 { return p.Value })}
+			ratio := float64(start.Sub(prev.Time)) / float64(next.Time.Sub(prev.Time))
+			p.Value = prev.Value + (next.Value-prev.Value)*ratio
+		}
+		s = append(s, p)
+	}
+
+	return linq.From(s)
+}
+
+// MovingAverage returns simple moving average over window points.
+// Each result is placed at time of the last point in its window, so the first window-1 points
+// have no result.
+// If window is lower than 1, then it returns empty list.
+func MovingAverage(points *linq.List[Point], window int) *linq.List[Point] {
+	p := points.ToSlice()
+	if window < 1 || len(p) < window {
+		return linq.From([]Point{})
+	}
+
+	s := make([]Point, 0, len(p)-window+1)
+	sum := 0.0
+	for i, point := range p {
+		sum += point.Value
+		if i >= window {
+			sum -= p[i-window].Value
+		}
+		if i >= window-1 {
+			s = append(s, Point{Time: point.Time, Value: sum / float64(window)})
+		}
+	}
+
+	return linq.From(s)
+}
+
+// ExponentialMovingAverage returns exponential moving average with smoothing factor alpha.
+// First result is value of first point.
+// If alpha is not in (0, 1], then it returns empty list.
+func ExponentialMovingAverage(points *linq.List[Point], alpha float64) *linq.List[Point] {
+	if alpha <= 0 || alpha > 1 {
+		return linq.From([]Point{})
+	}
+
+	s := make([]Point, 0, points.Count())
+	for i, point := range points.ToSlice() {
+		if i == 0 {
+			s = append(s, point)
+			continue
+		}
+		s = append(s, Point{Time: point.Time, Value: alpha*point.Value + (1-alpha)*s[i-1].Value})
+	}
+
+	return linq.From(s)
+}
+
+// Gaps returns intervals between consecutive timestamps which are longer than limit.
+func Gaps[T comparable](l *linq.List[T], ts func(value T) time.Time, limit time.Duration) *linq.List[Gap] {
+	times := make([]time.Time, 0, l.Count())
+	for _, t := range l.ToSlice() {
+		times = append(times, ts(t))
+	}
+	slices.SortFunc(times, time.Time.Compare)
+
+	s := make([]Gap, 0)
+	for i := 1; i < len(times); i++ {
+		if times[i].Sub(times[i-1]) > limit {
+			s = append(s, Gap{Start: times[i-1], End: times[i]})
+		}
+	}
+
+	return linq.From(s)
+}
+
+func locationOf(loc []*time.Location) *time.Location {
+	if len(loc) > 0 && loc[0] != nil {
+		return loc[0]
+	}
+	return time.UTC
+}
+
+// floor returns wall clock of start of bucket which contains t.
+// Wall clock in location is represented as time in UTC.
+func floor(t time.Time, width time.Duration, location *time.Location) time.Time {
+	t = t.In(location)
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	return time.Date(y, mo, d, h, mi, s, t.Nanosecond(), time.UTC).Truncate(width)
+}
+
+// fromWall returns time of wall clock in location.
+func fromWall(wall time.Time, location *time.Location) time.Time {
+	y, mo, d := wall.Date()
+	h, mi, s := wall.Clock()
+	return time.Date(y, mo, d, h, mi, s, wall.Nanosecond(), location)
+}
@@ -0,0 +1,237 @@
+package timeseries
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/YusukeKishino/go-linq"
+)
+
+type Event struct {
+	At    time.Time
+	Value float64
+}
+
+func at(hour, minute int) time.Time {
+	return time.Date(2022, 1, 2, hour, minute, 0, 0, time.UTC)
+}
+
+func eventTime(e Event) time.Time { return e.At }
+
+func eventValue(e Event) float64 { return e.Value }
+
+func TestBucketByTime(t *testing.T) {
+	events := linq.From([]Event{
+		{At: at(10, 40), Value: 1},
+		{At: at(9, 10), Value: 2},
+		{At: at(10, 5), Value: 3},
+		{At: at(12, 0), Value: 4},
+	})
+	got := BucketByTime(events, eventTime, time.Hour).ToSlice()
+	want := []Bucket[Event]{
+		{Start: at(9, 0), End: at(10, 0), Items: linq.From([]Event{{At: at(9, 10), Value: 2}})},
+		{Start: at(10, 0), End: at(11, 0), Items: linq.From([]Event{{At: at(10, 40), Value: 1}, {At: at(10, 5), Value: 3}})},
+		{Start: at(12, 0), End: at(13, 0), Items: linq.From([]Event{{At: at(12, 0), Value: 4}})},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BucketByTime() = %v, want %v", got, want)
+	}
+
+	if got := BucketByTime(events, eventTime, 0).Count(); got != 0 {
+		t.Errorf("BucketByTime() with zero width = %v buckets, want 0", got)
+	}
+}
+
+func TestBucketByTime_Location(t *testing.T) {
+	kolkata := time.FixedZone("IST", 5*60*60+30*60)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		at        time.Time
+		width     time.Duration
+		loc       *time.Location
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "half hour offset",
+			at:        time.Date(2022, 1, 2, 10, 45, 0, 0, kolkata),
+			width:     time.Hour,
+			loc:       kolkata,
+			wantStart: time.Date(2022, 1, 2, 10, 0, 0, 0, kolkata),
+			wantEnd:   time.Date(2022, 1, 2, 11, 0, 0, 0, kolkata),
+		},
+		{
+			name:      "day in location",
+			at:        time.Date(2022, 1, 2, 3, 0, 0, 0, time.UTC),
+			width:     24 * time.Hour,
+			loc:       newYork,
+			wantStart: time.Date(2022, 1, 1, 0, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2022, 1, 2, 0, 0, 0, 0, newYork),
+		},
+		{
+			name:      "day of daylight saving time",
+			at:        time.Date(2022, 3, 13, 12, 0, 0, 0, newYork),
+			width:     24 * time.Hour,
+			loc:       newYork,
+			wantStart: time.Date(2022, 3, 13, 0, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2022, 3, 14, 0, 0, 0, 0, newYork),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := BucketByTime(linq.From([]Event{{At: tt.at}}), eventTime, tt.width, tt.loc).MustFirst()
+			if !b.Start.Equal(tt.wantStart) || !b.End.Equal(tt.wantEnd) {
+				t.Errorf("BucketByTime() = [%v, %v), want [%v, %v)", b.Start, b.End, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestResample(t *testing.T) {
+	points := Points(linq.From([]Event{
+		{At: at(12, 30), Value: 8},
+		{At: at(9, 10), Value: 1},
+		{At: at(9, 50), Value: 3},
+	}), eventTime, eventValue)
+	tests := []struct {
+		name string
+		fill Fill
+		want []float64
+	}{
+		{
+			name: "fill zero",
+			fill: FillZero,
+			want: []float64{2, 0, 0, 8},
+		},
+		{
+			name: "fill previous",
+			fill: FillPrevious,
+			want: []float64{2, 2, 2, 8},
+		},
+		{
+			name: "fill linear",
+			fill: FillLinear,
+			want: []float64{2, 4, 6, 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resample(points, time.Hour, tt.fill).ToSlice()
+			if len(got) != len(tt.want) {
+				t.Fatalf("Resample() = %v, want %v", got, tt.want)
+			}
+			for i, p := range got {
+				if !p.Time.Equal(at(9+i, 0)) || p.Value != tt.want[i] {
+					t.Errorf("Resample()[%v] = %v, want %v at %v", i, p, tt.want[i], at(9+i, 0))
+				}
+			}
+		})
+	}
+}
+
+func TestResample_DaylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		points []Point
+		want   []Point
+	}{
+		{
+			name: "spring forward",
+			points: []Point{
+				{Time: time.Date(2024, 3, 10, 0, 30, 0, 0, newYork), Value: 1},
+				{Time: time.Date(2024, 3, 10, 4, 30, 0, 0, newYork), Value: 4},
+			},
+			want: []Point{
+				{Time: time.Date(2024, 3, 10, 5, 0, 0, 0, time.UTC), Value: 1},
+				{Time: time.Date(2024, 3, 10, 6, 0, 0, 0, time.UTC), Value: 2},
+				{Time: time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC), Value: 3},
+				{Time: time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC), Value: 4},
+			},
+		},
+		{
+			name: "fall back",
+			points: []Point{
+				{Time: time.Date(2024, 11, 3, 0, 30, 0, 0, newYork), Value: 1},
+				{Time: time.Date(2024, 11, 3, 3, 30, 0, 0, newYork), Value: 5},
+			},
+			want: []Point{
+				{Time: time.Date(2024, 11, 3, 4, 0, 0, 0, time.UTC), Value: 1},
+				{Time: time.Date(2024, 11, 3, 5, 0, 0, 0, time.UTC), Value: 2},
+				{Time: time.Date(2024, 11, 3, 7, 0, 0, 0, time.UTC), Value: 4},
+				{Time: time.Date(2024, 11, 3, 8, 0, 0, 0, time.UTC), Value: 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resample(linq.From(tt.points), time.Hour, FillLinear, newYork).ToSlice()
+			if len(got) != len(tt.want) {
+				t.Fatalf("Resample() = %v, want %v", got, tt.want)
+			}
+			for i, p := range got {
+				if !p.Time.Equal(tt.want[i].Time) || p.Value != tt.want[i].Value {
+					t.Errorf("Resample()[%v] = %v, want %v", i, p, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	points := linq.From([]Point{
+		{Time: at(1, 0), Value: 1},
+		{Time: at(2, 0), Value: 2},
+		{Time: at(3, 0), Value: 3},
+		{Time: at(4, 0), Value: 6},
+	})
+	want := []Point{
+		{Time: at(3, 0), Value: 2},
+		{Time: at(4, 0), Value: 11.0 / 3},
+	}
+	if got := MovingAverage(points, 3).ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MovingAverage() = %v, want %v", got, want)
+	}
+	if got := MovingAverage(points, 0).Count(); got != 0 {
+		t.Errorf("MovingAverage() with zero window = %v points, want 0", got)
+	}
+	if got := MovingAverage(points, 5).Count(); got != 0 {
+		t.Errorf("MovingAverage() with large window = %v points, want 0", got)
+	}
+}
+
+func TestExponentialMovingAverage(t *testing.T) {
+	points := linq.From([]Point{
+		{Time: at(1, 0), Value: 2},
+		{Time: at(2, 0), Value: 4},
+		{Time: at(3, 0), Value: 8},
+	})
+	want := []float64{2, 3, 5.5}
+	got := ExponentialMovingAverage(points, 0.5).ToSlice()
+	for i, p := range got {
+		if math.Abs(p.Value-want[i]) > 1e-9 || !p.Time.Equal(at(i+1, 0)) {
+			t.Errorf("ExponentialMovingAverage()[%v] = %v, want %v", i, p, want[i])
+		}
+	}
+	if got := ExponentialMovingAverage(points, 1.5).Count(); got != 0 {
+		t.Errorf("ExponentialMovingAverage() with invalid alpha = %v points, want 0", got)
+	}
+}
+
+func TestGaps(t *testing.T) {
+	events := linq.From([]Event{{At: at(9, 0)}, {At: at(13, 0)}, {At: at(9, 30)}, {At: at(10, 0)}})
+	want := []Gap{{Start: at(10, 0), End: at(13, 0)}}
+	if got := Gaps(events, eventTime, time.Hour).ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Gaps() = %v, want %v", got, want)
+	}
+}
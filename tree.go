@@ -0,0 +1,157 @@
+package linq
+
+// TreeOrder is order in which FromTree and FromGraph visit nodes.
+type TreeOrder int
+
+const (
+	// PreOrder visits node before its children in depth-first order.
+	PreOrder TreeOrder = iota
+	// PostOrder visits node after its children in depth-first order.
+	PostOrder
+	// BreadthFirst visits nodes level by level.
+	BreadthFirst
+)
+
+// TreeNode is node visited by FromTree or FromGraph.
+type TreeNode[T comparable] struct {
+	Value T
+	// Parent is value of parent node. It is zero value for root.
+	Parent T
+	// Depth is distance from root. It is 0 for root.
+	Depth int
+}
+
+// treePath is node with its ancestors.
+type treePath[T comparable] struct {
+	value  T
+	depth  int
+	parent *treePath[T]
+}
+
+func (p *treePath[T]) node() TreeNode[T] {
+	n := TreeNode[T]{Value: p.value, Depth: p.depth}
+	if p.parent != nil {
+		n.Parent = p.parent.value
+	}
+	return n
+}
+
+// hasAncestor reports whether value is one of ancestors of p, including p itself.
+func (p *treePath[T]) hasAncestor(value T) bool {
+	for ; p != nil; p = p.parent {
+		if p.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// FromTree returns lazy sequence of nodes reachable from root by children.
+// Nodes are visited in order, which defaults to PreOrder.
+// Nodes equal to one of their ancestors are skipped, so cyclic graphs can be traversed,
+// but nodes shared by several parents are visited once for each path from root.
+// Use FromGraph to visit each node only once.
+func FromTree[T comparable](root T, children func(value T) []T, order ...TreeOrder) *Sequence[TreeNode[T]] {
+	return walk(root, children, order, func() func(p *treePath[T], value T) bool {
+		return func(p *treePath[T], value T) bool {
+			return p.hasAncestor(value)
+		}
+	})
+}
+
+// FromGraph returns lazy sequence of nodes reachable from root by children.
+// Nodes are visited in order, which defaults to PreOrder.
+// Each node is visited only once, from the parent it is first reached by,
+// so time and memory are proportional to number of nodes and edges.
+func FromGraph[T comparable](root T, children func(value T) []T, order ...TreeOrder) *Sequence[TreeNode[T]] {
+	return walk(root, children, order, func() func(p *treePath[T], value T) bool {
+		visited := map[T]struct{}{root: {}}
+		return func(p *treePath[T], value T) bool {
+			if _, ok := visited[value]; ok {
+				return true
+			}
+			visited[value] = struct{}{}
+			return false
+		}
+	})
+}
+
+// walk returns lazy sequence of nodes reachable from root.
+// newSkip is called for each enumeration and returns function which reports whether child value of p is skipped.
+func walk[T comparable](root T, children func(value T) []T, order []TreeOrder, newSkip func() func(p *treePath[T], value T) bool) *Sequence[TreeNode[T]] {
+	o := PreOrder
+	if len(order) > 0 {
+		o = order[0]
+	}
+
+	return FromSeq(func(yield func(TreeNode[T]) bool) {
+		rootPath := &treePath[T]{value: root}
+		skip := newSkip()
+		if o == BreadthFirst {
+			walkBreadthFirst(rootPath, children, skip, yield)
+			return
+		}
+		walkDepthFirst(rootPath, children, o, skip, yield)
+	})
+}
+
+func walkDepthFirst[T comparable](p *treePath[T], children func(value T) []T, order TreeOrder, skip func(p *treePath[T], value T) bool, yield func(TreeNode[T]) bool) bool {
+	if order == PreOrder && !yield(p.node()) {
+		return false
+	}
+	for _, c := range children(p.value) {
+		if skip(p, c) {
+			continue
+		}
+		if !walkDepthFirst(&treePath[T]{value: c, depth: p.depth + 1, parent: p}, children, order, skip, yield) {
+			return false
+		}
+	}
+	if order == PostOrder && !yield(p.node()) {
+		return false
+	}
+
+	return true
+}
+
+func walkBreadthFirst[T comparable](root *treePath[T], children func(value T) []T, skip func(p *treePath[T], value T) bool, yield func(TreeNode[T]) bool) {
+	queue := []*treePath[T]{root}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if !yield(p.node()) {
+			return
+		}
+		for _, c := range children(p.value) {
+			if !skip(p, c) {
+				queue = append(queue, &treePath[T]{value: c, depth: p.depth + 1, parent: p})
+			}
+		}
+	}
+}
+
+// TreeValues returns values of nodes.
+func TreeValues[T comparable](nodes *Sequence[TreeNode[T]]) *Sequence[T] {
+	seq := nodes.Seq()
+	return FromSeq(func(yield func(T) bool) {
+		for n := range seq {
+			if !yield(n.Value) {
+				return
+			}
+		}
+	})
+}
+
+// Flatten returns elements of all lists in order.
+func Flatten[T comparable](l *List[*List[T]]) *List[T] {
+	n := 0
+	for _, inner := range l.items() {
+		n += len(inner.items())
+	}
+	s := make([]T, 0, n)
+	for _, inner := range l.items() {
+		s = append(s, inner.items()...)
+	}
+
+	return From(s)
+}
@@ -0,0 +1,177 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testTree is children of each node in tree rooted at 1.
+var testTree = map[T][]T{
+	1: {2, 3},
+	2: {4, 5},
+	3: {6},
+}
+
+func TestFromTree(t *testing.T) {
+	children := func(v T) []T { return testTree[v] }
+	tests := []struct {
+		name  string
+		order []TreeOrder
+		want  []T
+	}{
+		{
+			name: "pre-order by default",
+			want: []T{1, 2, 4, 5, 3, 6},
+		},
+		{
+			name:  "post-order",
+			order: []TreeOrder{PostOrder},
+			want:  []T{4, 5, 2, 6, 3, 1},
+		},
+		{
+			name:  "breadth first",
+			order: []TreeOrder{BreadthFirst},
+			want:  []T{1, 2, 3, 4, 5, 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TreeValues(FromTree(1, children, tt.order...)).ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromTree() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromTree_Nodes(t *testing.T) {
+	children := func(v T) []T { return testTree[v] }
+	got, err := FromTree(1, children).First(func(n TreeNode[T], i int) bool { return n.Value == 5 })
+	if want := (TreeNode[T]{Value: 5, Parent: 2, Depth: 2}); err != nil || got != want {
+		t.Errorf("First() = %v, %v, want %v", got, err, want)
+	}
+	if got := FromTree(1, children).Count(func(n TreeNode[T], i int) bool { return n.Depth == 1 }); got != 2 {
+		t.Errorf("Count() = %v, want %v", got, 2)
+	}
+}
+
+func TestFromTree_Cycle(t *testing.T) {
+	graph := map[T][]T{
+		1: {2},
+		2: {3, 1},
+		3: {1, 2, 4},
+	}
+	children := func(v T) []T { return graph[v] }
+	for _, order := range []TreeOrder{PreOrder, PostOrder, BreadthFirst} {
+		if got := FromTree(1, children, order).Count(); got != 4 {
+			t.Errorf("FromTree(%v).Count() = %v, want %v", order, got, 4)
+		}
+	}
+}
+
+func TestFromGraph(t *testing.T) {
+	// 1 and 4 are connected by 2 and 3, and 4 leads back to 1.
+	diamond := map[T][]T{
+		1: {2, 3},
+		2: {4},
+		3: {4},
+		4: {1},
+	}
+	children := func(v T) []T { return diamond[v] }
+	tests := []struct {
+		name  string
+		order TreeOrder
+		want  []TreeNode[T]
+	}{
+		{
+			name:  "pre order",
+			order: PreOrder,
+			want:  []TreeNode[T]{{Value: 1}, {Value: 2, Parent: 1, Depth: 1}, {Value: 4, Parent: 2, Depth: 2}, {Value: 3, Parent: 1, Depth: 1}},
+		},
+		{
+			name:  "post order",
+			order: PostOrder,
+			want:  []TreeNode[T]{{Value: 4, Parent: 2, Depth: 2}, {Value: 2, Parent: 1, Depth: 1}, {Value: 3, Parent: 1, Depth: 1}, {Value: 1}},
+		},
+		{
+			name:  "breadth first",
+			order: BreadthFirst,
+			want:  []TreeNode[T]{{Value: 1}, {Value: 2, Parent: 1, Depth: 1}, {Value: 3, Parent: 1, Depth: 1}, {Value: 4, Parent: 2, Depth: 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromGraph(1, children, tt.order).ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromGraph() = %v, want %v", got, tt.want)
+			}
+			if got := FromTree(1, children, tt.order).Count(); got != 5 {
+				t.Errorf("FromTree().Count() = %v, want %v", got, 5)
+			}
+		})
+	}
+}
+
+func TestFromGraph_Dense(t *testing.T) {
+	const n = 9
+	visited := 0
+	complete := func(v T) []T {
+		visited++
+		s := make([]T, 0, n-1)
+		for i := T(0); i < n; i++ {
+			if i != v {
+				s = append(s, i)
+			}
+		}
+		return s
+	}
+	seq := FromGraph(0, complete)
+	for _, pass := range []string{"first", "second"} {
+		visited = 0
+		if got := seq.Count(); got != n {
+			t.Errorf("%v Count() = %v, want %v", pass, got, n)
+		}
+		if visited != n {
+			t.Errorf("%v children called %v times, want %v", pass, visited, n)
+		}
+	}
+}
+
+func TestFromTree_Lazy(t *testing.T) {
+	visited := 0
+	children := func(v T) []T {
+		visited++
+		return []T{v * 2, v*2 + 1}
+	}
+	got, err := TreeValues(FromTree(1, children)).First(func(v T, i int) bool { return v > 100 })
+	if err != nil || got != 128 {
+		t.Errorf("First() = %v, %v, want %v", got, err, 128)
+	}
+	if visited != 7 {
+		t.Errorf("children called %v times, want %v", visited, 7)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name string
+		list *List[*List[T]]
+		want *List[T]
+	}{
+		{
+			name: "flatten lists",
+			list: From([]*List[T]{From([]T{1, 2}), nil, From([]T{}), From([]T{3})}),
+			want: &List[T]{slice: []T{1, 2, 3}},
+		},
+		{
+			name: "nil list",
+			list: nil,
+			want: &List[T]{slice: []T{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Flatten(tt.list); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
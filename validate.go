@@ -0,0 +1,103 @@
+package linq
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError is errors of an element which failed validation.
+type ValidationError[T comparable] struct {
+	// Index is index of the element in list.
+	Index int
+	Value T
+	// Errs is errors returned by rules in order.
+	Errs []error
+}
+
+func (e *ValidationError[T]) Error() string {
+	s := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		s[i] = err.Error()
+	}
+	return fmt.Sprintf("index %v: %v", e.Index, strings.Join(s, "; "))
+}
+
+func (e *ValidationError[T]) Unwrap() []error {
+	return e.Errs
+}
+
+// ValidationErrors is list of ValidationError ordered by index.
+type ValidationErrors[T comparable] []*ValidationError[T]
+
+func (e ValidationErrors[T]) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+func (e ValidationErrors[T]) Unwrap() []error {
+	s := make([]error, len(e))
+	for i, err := range e {
+		s[i] = err
+	}
+	return s
+}
+
+// Values returns invalid elements.
+func (e ValidationErrors[T]) Values() *List[T] {
+	s := make([]T, len(e))
+	for i, err := range e {
+		s[i] = err.Value
+	}
+	return From(s)
+}
+
+func (e ValidationErrors[T]) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Validate returns elements which pass all rules.
+// Every rule is applied to every element, and elements which fail any rule are reported
+// as ValidationErrors.
+func (l *List[T]) Validate(rules ...func(value T, index int) error) (*List[T], error) {
+	sp := l.span("Validate")
+	s := make([]T, 0, len(l.items()))
+	var errs ValidationErrors[T]
+	for i, t := range l.items() {
+		var e []error
+		for _, rule := range rules {
+			if err := rule(t, i); err != nil {
+				e = append(e, err)
+			}
+		}
+		if len(e) > 0 {
+			errs = append(errs, &ValidationError[T]{Index: i, Value: t, Errs: e})
+			continue
+		}
+		s = append(s, t)
+	}
+
+	return sp.end(s), errs.err()
+}
+
+// Partition returns condition matched elements and the others.
+// When tracing, each of them is logged as output.
+func (l *List[T]) Partition(f func(value T, index int) bool) (*List[T], *List[T]) {
+	sp := l.span("Partition")
+	matched := make([]T, 0, len(l.items()))
+	unmatched := make([]T, 0)
+	for i, t := range l.items() {
+		if f(t, i) {
+			matched = append(matched, t)
+		} else {
+			unmatched = append(unmatched, t)
+		}
+	}
+
+	return sp.end(matched), sp.end(unmatched)
+}
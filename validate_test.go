@@ -0,0 +1,116 @@
+package linq
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var (
+	errNegative = errors.New("negative")
+	errOdd      = errors.New("odd")
+)
+
+func notNegative(value T, index int) error {
+	if value < 0 {
+		return errNegative
+	}
+	return nil
+}
+
+func notOdd(value T, index int) error {
+	if value%2 != 0 {
+		return errOdd
+	}
+	return nil
+}
+
+func TestList_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		slice    []T
+		want     *List[T]
+		wantErrs ValidationErrors[T]
+	}{
+		{
+			name:  "all valid",
+			slice: []T{2, 4},
+			want:  &List[T]{slice: []T{2, 4}},
+		},
+		{
+			name:  "accumulate errors",
+			slice: []T{2, -1, 3, -4, 6},
+			want:  &List[T]{slice: []T{2, 6}},
+			wantErrs: ValidationErrors[T]{
+				{Index: 1, Value: -1, Errs: []error{errNegative, errOdd}},
+				{Index: 2, Value: 3, Errs: []error{errOdd}},
+				{Index: 3, Value: -4, Errs: []error{errNegative}},
+			},
+		},
+		{
+			name:  "empty list",
+			slice: []T{},
+			want:  &List[T]{slice: []T{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := From(tt.slice).Validate(notNegative, notOdd)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			var errs ValidationErrors[T]
+			if errors.As(err, &errs) != (tt.wantErrs != nil) || !reflect.DeepEqual(errs, tt.wantErrs) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	_, err := From([]T{1, -2}).Validate(notNegative, notOdd)
+	if want := "index 0: odd\nindex 1: negative"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if !errors.Is(err, errOdd) || !errors.Is(err, errNegative) {
+		t.Errorf("errors.Is() = false for rule errors")
+	}
+
+	var e *ValidationError[T]
+	if !errors.As(errors.Join(errors.New("other"), err), &e) || e.Index != 0 {
+		t.Errorf("errors.As() = %v, want error of index 0", e)
+	}
+
+	var errs ValidationErrors[T]
+	errors.As(err, &errs)
+	if got := errs.Values(); !reflect.DeepEqual(got, &List[T]{slice: []T{1, -2}}) {
+		t.Errorf("Values() = %v", got)
+	}
+}
+
+func TestList_Partition(t *testing.T) {
+	matched, unmatched := From([]T{1, 2, 3, 4, 5}).Partition(func(value T, index int) bool {
+		return value%2 == 0
+	})
+	if want := (&List[T]{slice: []T{2, 4}}); !reflect.DeepEqual(matched, want) {
+		t.Errorf("Partition() matched = %v, want %v", matched, want)
+	}
+	if want := (&List[T]{slice: []T{1, 3, 5}}); !reflect.DeepEqual(unmatched, want) {
+		t.Errorf("Partition() unmatched = %v, want %v", unmatched, want)
+	}
+}
+
+func TestList_Validate_Trace(t *testing.T) {
+	var b bytes.Buffer
+	l := From([]T{-1, 2, 3, 4}).Trace(traceLogger(&b))
+
+	valid, _ := l.Validate(notNegative)
+	valid.Partition(func(v T, i int) bool { return v%2 == 0 })
+	want := "level=DEBUG msg=linq op=Validate in=4 out=3\n" +
+		"level=DEBUG msg=linq op=Partition in=3 out=2\n" +
+		"level=DEBUG msg=linq op=Partition in=3 out=1\n"
+	if b.String() != want {
+		t.Errorf("Trace() logged %v, want %v", b.String(), want)
+	}
+}